@@ -27,10 +27,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/chuckpreslar/emission"
 	"github.com/go-playground/validator"
 	"github.com/gorilla/websocket"
-	"github.com/linstohu/nexapi/binance/utils"
+	bnutils "github.com/linstohu/nexapi/binance/utils"
+	"github.com/linstohu/nexapi/utils"
 	cmap "github.com/orcaman/concurrent-map/v2"
 )
 
@@ -54,7 +54,8 @@ type CoinMarginedMarketStreamClient struct {
 	sending       sync.Mutex
 	subscriptions cmap.ConcurrentMap[string, struct{}]
 
-	emitter *emission.Emitter
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
 }
 
 type CoinMarginedMarketStreamCfg struct {
@@ -63,6 +64,9 @@ type CoinMarginedMarketStreamCfg struct {
 	AutoReconnect bool   `validate:"required"`
 
 	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
 }
 
 func NewMarketStreamClient(cfg *CoinMarginedMarketStreamCfg) (*CoinMarginedMarketStreamClient, error) {
@@ -78,13 +82,17 @@ func NewMarketStreamClient(cfg *CoinMarginedMarketStreamCfg) (*CoinMarginedMarke
 		autoReconnect: cfg.AutoReconnect,
 
 		subscriptions: cmap.New[struct{}](),
-		emitter:       emission.NewEmitter(),
+		emitter:       utils.NewEmitter(),
 	}
 
 	if cli.logger == nil {
 		cli.logger = slog.Default()
 	}
 
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
 	return cli, nil
 }
 
@@ -110,6 +118,10 @@ func (u *CoinMarginedMarketStreamClient) Close() error {
 
 	u.cancel()
 
+	if u.dispatcher != nil {
+		u.dispatcher.Close()
+	}
+
 	return nil
 }
 
@@ -219,7 +231,7 @@ func (u *CoinMarginedMarketStreamClient) readMessages() {
 			u.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
 			return
 		default:
-			var msg utils.AnyMessage
+			var msg bnutils.AnyMessage
 			err := u.conn.ReadJSON(&msg)
 			if err != nil {
 				u.logger.Info(fmt.Sprintf("%s: read message error, %s", logPrefix, err))
@@ -254,7 +266,7 @@ func (u *CoinMarginedMarketStreamClient) resubscribe() error {
 	}
 
 	// do subscription
-	err := u.send(&utils.Request{
+	err := u.send(&bnutils.Request{
 		ID:     rand.Uint32(),
 		Method: SUBSCRIBE,
 		Params: topics,
@@ -282,7 +294,7 @@ func (u *CoinMarginedMarketStreamClient) subscribe(topics []string) error {
 	}
 
 	// do subscription
-	err := u.send(&utils.Request{
+	err := u.send(&bnutils.Request{
 		ID:     rand.Uint32(),
 		Method: SUBSCRIBE,
 		Params: ts,
@@ -300,7 +312,7 @@ func (u *CoinMarginedMarketStreamClient) subscribe(topics []string) error {
 }
 
 func (u *CoinMarginedMarketStreamClient) unsubscribe(topics []string) error {
-	err := u.send(&utils.Request{
+	err := u.send(&bnutils.Request{
 		ID:     rand.Uint32(),
 		Method: UNSUBSCRIBE,
 		Params: topics,
@@ -317,7 +329,7 @@ func (u *CoinMarginedMarketStreamClient) unsubscribe(topics []string) error {
 	return nil
 }
 
-func (u *CoinMarginedMarketStreamClient) send(req *utils.Request) error {
+func (u *CoinMarginedMarketStreamClient) send(req *bnutils.Request) error {
 	u.sending.Lock()
 	defer u.sending.Unlock()
 
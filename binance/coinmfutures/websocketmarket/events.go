@@ -17,18 +17,86 @@
 
 package websocketmarket
 
-import "github.com/chuckpreslar/emission"
+import (
+	spottypes "github.com/linstohu/nexapi/binance/spot/websocketmarket/types"
+	usdmtypes "github.com/linstohu/nexapi/binance/usdmfutures/websocketmarket/types"
+	"github.com/linstohu/nexapi/utils"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (u *CoinMarginedMarketStreamClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (u *CoinMarginedMarketStreamClient) AddListener(event string, listener Listener) func() {
 	return u.emitter.On(event, listener)
 }
 
-func (u *CoinMarginedMarketStreamClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return u.emitter.Off(u, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (u *CoinMarginedMarketStreamClient) RemoveListener(event string, listener Listener) {
+	u.emitter.Off(event, listener)
 }
 
-func (u *CoinMarginedMarketStreamClient) GetListeners(event string, argument any) *emission.Emitter {
-	return u.emitter.Emit(event, argument)
+func (u *CoinMarginedMarketStreamClient) GetListeners(event string, argument any) {
+	if u.dispatcher != nil {
+		u.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	u.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (u *CoinMarginedMarketStreamClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if u.dispatcher == nil {
+		return nil
+	}
+
+	return u.dispatcher.Metrics()
+}
+
+func (u *CoinMarginedMarketStreamClient) OnAggTrade(topic string, fn func(*usdmtypes.AggregateTrade)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnIndexPrice(topic string, fn func(*IndexPrice)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnMarkPrice(topic string, fn func(*MarkPrice)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnPairMarkPrice(topic string, fn func([]*MarkPrice)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnKline(topic string, fn func(*spottypes.Kline)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnMiniTicker(topic string, fn func(*MiniTicker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnAllMarketMiniTickers(topic string, fn func([]*MiniTicker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnTicker(topic string, fn func(*Ticker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnAllMarketTickers(topic string, fn func([]*Ticker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnBookTicker(topic string, fn func(*BookTicker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnLiquidationOrder(topic string, fn func(*LiquidationOrder)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *CoinMarginedMarketStreamClient) OnBookDepth(topic string, fn func(*OrderbookDepth)) func() {
+	return utils.On(u, topic, fn)
 }
@@ -17,18 +17,68 @@
 
 package websocketmarket
 
-import "github.com/chuckpreslar/emission"
+import (
+	"github.com/linstohu/nexapi/utils"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (o *OptionsMarketStreamClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (o *OptionsMarketStreamClient) AddListener(event string, listener Listener) func() {
 	return o.emitter.On(event, listener)
 }
 
-func (o *OptionsMarketStreamClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return o.emitter.Off(o, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (o *OptionsMarketStreamClient) RemoveListener(event string, listener Listener) {
+	o.emitter.Off(event, listener)
 }
 
-func (o *OptionsMarketStreamClient) GetListeners(event string, argument any) *emission.Emitter {
-	return o.emitter.Emit(event, argument)
+func (o *OptionsMarketStreamClient) GetListeners(event string, argument any) {
+	if o.dispatcher != nil {
+		o.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	o.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (o *OptionsMarketStreamClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if o.dispatcher == nil {
+		return nil
+	}
+
+	return o.dispatcher.Metrics()
+}
+
+func (o *OptionsMarketStreamClient) OnTrade(topic string, fn func(*Trade)) func() {
+	return utils.On(o, topic, fn)
+}
+
+func (o *OptionsMarketStreamClient) OnIndexPrice(topic string, fn func(*IndexPrice)) func() {
+	return utils.On(o, topic, fn)
+}
+
+func (o *OptionsMarketStreamClient) OnMarkPrice(topic string, fn func([]*MarkPrice)) func() {
+	return utils.On(o, topic, fn)
+}
+
+func (o *OptionsMarketStreamClient) OnKline(topic string, fn func(*Kline)) func() {
+	return utils.On(o, topic, fn)
+}
+
+func (o *OptionsMarketStreamClient) OnTicker(topic string, fn func(*Ticker)) func() {
+	return utils.On(o, topic, fn)
+}
+
+func (o *OptionsMarketStreamClient) OnUnderlyingTickers(topic string, fn func([]*Ticker)) func() {
+	return utils.On(o, topic, fn)
+}
+
+func (o *OptionsMarketStreamClient) OnOpenInterest(topic string, fn func([]*OpenInterest)) func() {
+	return utils.On(o, topic, fn)
+}
+
+func (o *OptionsMarketStreamClient) OnBookDepth(topic string, fn func(*OrderbookDepth)) func() {
+	return utils.On(o, topic, fn)
 }
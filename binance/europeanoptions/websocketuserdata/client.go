@@ -26,10 +26,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/chuckpreslar/emission"
 	"github.com/go-playground/validator"
 	"github.com/gorilla/websocket"
 	eoutils "github.com/linstohu/nexapi/binance/europeanoptions/utils"
+	"github.com/linstohu/nexapi/utils"
 )
 
 type OptionsUserDataStreamClient struct {
@@ -52,7 +52,8 @@ type OptionsUserDataStreamClient struct {
 	disconnect    chan struct{}
 	heartCancel   chan struct{}
 
-	emitter *emission.Emitter
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
 }
 
 type OptionsUserDataStreamCfg struct {
@@ -64,6 +65,9 @@ type OptionsUserDataStreamCfg struct {
 	Key           string `validate:"required"`
 	Secret        string `validate:"required"`
 	AutoReconnect bool   `validate:"required"`
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
 }
 
 func NewUserDataStreamClient(cfg *OptionsUserDataStreamCfg) (*OptionsUserDataStreamClient, error) {
@@ -81,13 +85,17 @@ func NewUserDataStreamClient(cfg *OptionsUserDataStreamCfg) (*OptionsUserDataStr
 
 		autoReconnect: cfg.AutoReconnect,
 
-		emitter: emission.NewEmitter(),
+		emitter: utils.NewEmitter(),
 	}
 
 	if cli.logger == nil {
 		cli.logger = slog.Default()
 	}
 
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
 	return cli, nil
 }
 
@@ -113,6 +121,10 @@ func (o *OptionsUserDataStreamClient) Close() error {
 
 	o.cancel()
 
+	if o.dispatcher != nil {
+		o.dispatcher.Close()
+	}
+
 	return nil
 }
 
@@ -17,18 +17,44 @@
 
 package websocketuserdata
 
-import "github.com/chuckpreslar/emission"
+import (
+	"github.com/linstohu/nexapi/utils"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (o *OptionsUserDataStreamClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (o *OptionsUserDataStreamClient) AddListener(event string, listener Listener) func() {
 	return o.emitter.On(event, listener)
 }
 
-func (o *OptionsUserDataStreamClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return o.emitter.Off(o, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (o *OptionsUserDataStreamClient) RemoveListener(event string, listener Listener) {
+	o.emitter.Off(event, listener)
 }
 
-func (o *OptionsUserDataStreamClient) GetListeners(event string, argument any) *emission.Emitter {
-	return o.emitter.Emit(event, argument)
+func (o *OptionsUserDataStreamClient) GetListeners(event string, argument any) {
+	if o.dispatcher != nil {
+		o.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	o.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (o *OptionsUserDataStreamClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if o.dispatcher == nil {
+		return nil
+	}
+
+	return o.dispatcher.Metrics()
+}
+
+func (o *OptionsUserDataStreamClient) OnAccountData(fn func(*AccountData)) func() {
+	return utils.On(o, o.GenAccountDataTopic(), fn)
+}
+
+func (o *OptionsUserDataStreamClient) OnOrderUpdate(fn func(*OrderUpdate)) func() {
+	return utils.On(o, o.GenOrderUpdateTopic(), fn)
 }
@@ -27,10 +27,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/chuckpreslar/emission"
 	"github.com/go-playground/validator"
 	"github.com/gorilla/websocket"
-	"github.com/linstohu/nexapi/binance/utils"
+	bnutils "github.com/linstohu/nexapi/binance/utils"
+	"github.com/linstohu/nexapi/utils"
 	cmap "github.com/orcaman/concurrent-map/v2"
 )
 
@@ -54,7 +54,8 @@ type SpotMarketStreamClient struct {
 	sending       sync.Mutex
 	subscriptions cmap.ConcurrentMap[string, struct{}]
 
-	emitter *emission.Emitter
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
 }
 
 type SpotMarketStreamCfg struct {
@@ -63,6 +64,9 @@ type SpotMarketStreamCfg struct {
 	AutoReconnect bool   `validate:"required"`
 
 	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
 }
 
 func NewSpotMarketStreamClient(cfg *SpotMarketStreamCfg) (*SpotMarketStreamClient, error) {
@@ -78,13 +82,17 @@ func NewSpotMarketStreamClient(cfg *SpotMarketStreamCfg) (*SpotMarketStreamClien
 		autoReconnect: cfg.AutoReconnect,
 
 		subscriptions: cmap.New[struct{}](),
-		emitter:       emission.NewEmitter(),
+		emitter:       utils.NewEmitter(),
 	}
 
 	if cli.logger == nil {
 		cli.logger = slog.Default()
 	}
 
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
 	return cli, nil
 }
 
@@ -110,6 +118,10 @@ func (m *SpotMarketStreamClient) Close() error {
 
 	m.cancel()
 
+	if m.dispatcher != nil {
+		m.dispatcher.Close()
+	}
+
 	return nil
 }
 
@@ -219,7 +231,7 @@ func (m *SpotMarketStreamClient) readMessages() {
 			m.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
 			return
 		default:
-			var msg utils.AnyMessage
+			var msg bnutils.AnyMessage
 			err := m.conn.ReadJSON(&msg)
 			if err != nil {
 				m.logger.Info(fmt.Sprintf("%s: read message error, %s", logPrefix, err))
@@ -254,7 +266,7 @@ func (m *SpotMarketStreamClient) resubscribe() error {
 	}
 
 	// do subscription
-	err := m.send(&utils.Request{
+	err := m.send(&bnutils.Request{
 		ID:     rand.Uint32(),
 		Method: SUBSCRIBE,
 		Params: topics,
@@ -282,7 +294,7 @@ func (m *SpotMarketStreamClient) subscribe(topics []string) error {
 	}
 
 	// do subscription
-	err := m.send(&utils.Request{
+	err := m.send(&bnutils.Request{
 		ID:     rand.Uint32(),
 		Method: SUBSCRIBE,
 		Params: ts,
@@ -300,7 +312,7 @@ func (m *SpotMarketStreamClient) subscribe(topics []string) error {
 }
 
 func (m *SpotMarketStreamClient) unsubscribe(topics []string) error {
-	err := m.send(&utils.Request{
+	err := m.send(&bnutils.Request{
 		ID:     rand.Uint32(),
 		Method: UNSUBSCRIBE,
 		Params: topics,
@@ -317,7 +329,7 @@ func (m *SpotMarketStreamClient) unsubscribe(topics []string) error {
 	return nil
 }
 
-func (m *SpotMarketStreamClient) send(req *utils.Request) error {
+func (m *SpotMarketStreamClient) send(req *bnutils.Request) error {
 	m.sending.Lock()
 	defer m.sending.Unlock()
 
@@ -24,6 +24,7 @@ import (
 
 	spotws "github.com/linstohu/nexapi/binance/spot/websocketmarket"
 	"github.com/linstohu/nexapi/binance/spot/websocketmarket/types"
+	"github.com/linstohu/nexapi/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -67,3 +68,34 @@ func TestSubscribeAggTrade(t *testing.T) {
 
 	select {}
 }
+
+func TestSubscribeKlineChan(t *testing.T) {
+	cli := testNewSpotMarketStreamClient(t)
+	err := cli.Open()
+	assert.Nil(t, err)
+
+	topic, err := cli.GetKlineTopic(&spotws.KlineTopicParam{
+		Symbol:   "btcusdt",
+		Interval: "1m",
+	})
+	assert.Nil(t, err)
+
+	klines, unsubscribe := utils.Chan[*types.Kline](cli, topic, utils.ChanCfg{
+		Buffer: 16,
+		Policy: utils.DropOldest,
+	})
+
+	cli.Subscribe([]string{topic})
+
+	go func() {
+		time.Sleep(10 * time.Second)
+		unsubscribe()
+	}()
+
+	for kline := range klines {
+		fmt.Printf("Topic: %s, Symbol: %v, Close: %v, Time: %v\n",
+			topic, kline.Symbol, kline.Kline.ClosePrice, kline.EventTime)
+	}
+
+	cli.Close()
+}
@@ -17,18 +17,77 @@
 
 package websocketmarket
 
-import "github.com/chuckpreslar/emission"
+import (
+	"github.com/linstohu/nexapi/binance/spot/websocketmarket/types"
+	"github.com/linstohu/nexapi/utils"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (m *SpotMarketStreamClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (m *SpotMarketStreamClient) AddListener(event string, listener Listener) func() {
 	return m.emitter.On(event, listener)
 }
 
-func (m *SpotMarketStreamClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return m.emitter.Off(m, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (m *SpotMarketStreamClient) RemoveListener(event string, listener Listener) {
+	m.emitter.Off(event, listener)
 }
 
-func (m *SpotMarketStreamClient) GetListeners(event string, argument any) *emission.Emitter {
-	return m.emitter.Emit(event, argument)
+func (m *SpotMarketStreamClient) GetListeners(event string, argument any) {
+	if m.dispatcher != nil {
+		m.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	m.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (m *SpotMarketStreamClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if m.dispatcher == nil {
+		return nil
+	}
+
+	return m.dispatcher.Metrics()
+}
+
+func (m *SpotMarketStreamClient) OnAggTrade(topic string, fn func(*types.AggregateTrade)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnTrade(topic string, fn func(*types.Trade)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnKline(topic string, fn func(*types.Kline)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnMiniTicker(topic string, fn func(*types.MiniTicker)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnAllMarketMiniTickers(topic string, fn func([]*types.MiniTicker)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnTicker(topic string, fn func(*types.Ticker)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnAllMarketTickers(topic string, fn func([]*types.Ticker)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnBookTicker(topic string, fn func(*types.BookTicker)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnBookDepth(topic string, fn func(*types.OrderbookDepth)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *SpotMarketStreamClient) OnBookDiffDepth(topic string, fn func(*types.OrderbookDiffDepth)) func() {
+	return utils.On(m, topic, fn)
 }
@@ -25,6 +25,7 @@ import (
 	spottypes "github.com/linstohu/nexapi/binance/spot/websocketmarket/types"
 	usdmws "github.com/linstohu/nexapi/binance/usdmfutures/websocketmarket"
 	"github.com/linstohu/nexapi/binance/usdmfutures/websocketmarket/types"
+	"github.com/linstohu/nexapi/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -398,3 +399,38 @@ func TestSubscribeBookDiffDepth(t *testing.T) {
 
 	select {}
 }
+
+func TestSubscribeAggTradeWithDispatcher(t *testing.T) {
+	cli, err := usdmws.NewMarketStreamClient(&usdmws.USDMarginedMarketStreamCfg{
+		Debug:         false,
+		BaseURL:       usdmws.USDMarginedMarketStreamBaseURL,
+		AutoReconnect: true,
+		Dispatcher: &utils.DispatcherCfg{
+			QueueSize: 64,
+			Policy:    utils.DropOldest,
+		},
+	})
+	assert.Nil(t, err)
+
+	err = cli.Open()
+	assert.Nil(t, err)
+
+	topic, err := cli.GetAggTradeTopic("btcusdt")
+	assert.Nil(t, err)
+
+	cli.OnAggTrade(topic, func(trade *types.AggregateTrade) {
+		// a slow listener no longer stalls the read goroutine
+		time.Sleep(100 * time.Millisecond)
+	})
+
+	cli.Subscribe([]string{topic})
+
+	time.Sleep(5 * time.Second)
+
+	for topic, m := range cli.DispatcherMetrics() {
+		fmt.Printf("Topic: %s, Depth: %v, Delivered: %v, Dropped: %v\n",
+			topic, m.Depth, m.Delivered, m.Dropped)
+	}
+
+	cli.Close()
+}
@@ -17,18 +17,82 @@
 
 package websocketmarket
 
-import "github.com/chuckpreslar/emission"
+import (
+	spottypes "github.com/linstohu/nexapi/binance/spot/websocketmarket/types"
+	"github.com/linstohu/nexapi/binance/usdmfutures/websocketmarket/types"
+	"github.com/linstohu/nexapi/utils"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (u *USDMarginedMarketStreamClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (u *USDMarginedMarketStreamClient) AddListener(event string, listener Listener) func() {
 	return u.emitter.On(event, listener)
 }
 
-func (u *USDMarginedMarketStreamClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return u.emitter.Off(u, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (u *USDMarginedMarketStreamClient) RemoveListener(event string, listener Listener) {
+	u.emitter.Off(event, listener)
 }
 
-func (u *USDMarginedMarketStreamClient) GetListeners(event string, argument any) *emission.Emitter {
-	return u.emitter.Emit(event, argument)
+func (u *USDMarginedMarketStreamClient) GetListeners(event string, argument any) {
+	if u.dispatcher != nil {
+		u.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	u.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (u *USDMarginedMarketStreamClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if u.dispatcher == nil {
+		return nil
+	}
+
+	return u.dispatcher.Metrics()
+}
+
+func (u *USDMarginedMarketStreamClient) OnAggTrade(topic string, fn func(*types.AggregateTrade)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnMarkPrice(topic string, fn func(*types.MarkPrice)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnAllMarketPrice(topic string, fn func([]*types.MarkPrice)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnKline(topic string, fn func(*spottypes.Kline)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnMiniTicker(topic string, fn func(*spottypes.MiniTicker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnAllMarketMiniTickers(topic string, fn func([]*spottypes.MiniTicker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnTicker(topic string, fn func(*types.Ticker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnAllMarketTickers(topic string, fn func([]*types.Ticker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnBookTicker(topic string, fn func(*types.BookTicker)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnLiquidationOrder(topic string, fn func(*types.LiquidationOrder)) func() {
+	return utils.On(u, topic, fn)
+}
+
+func (u *USDMarginedMarketStreamClient) OnBookDepth(topic string, fn func(*types.OrderbookDepth)) func() {
+	return utils.On(u, topic, fn)
 }
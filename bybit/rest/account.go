@@ -0,0 +1,414 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linstohu/nexapi/bybit/rest/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+// UpgradeToUTA upgrades the account to the unified trading account
+// doc: https://bybit-exchange.github.io/docs/v5/account/upgrade-unified-account
+func (bb *BybitClient) UpgradeToUTA(ctx context.Context) (*types.UpgradeToUTAResp, error) {
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/upgrade-to-uta",
+		Method:  http.MethodPost,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.UpgradeToUTAAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.UpgradeToUTAResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetBorrowHistory
+// doc: https://bybit-exchange.github.io/docs/v5/account/borrow-history
+func (bb *BybitClient) GetBorrowHistory(ctx context.Context, param types.GetBorrowHistoryParam) (*types.GetBorrowHistoryResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/borrow-history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetBorrowHistoryAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetBorrowHistoryResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetCollateralInfo
+// doc: https://bybit-exchange.github.io/docs/v5/account/collateral-info
+func (bb *BybitClient) GetCollateralInfo(ctx context.Context, param types.GetCollateralInfoParam) (*types.GetCollateralInfoResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/collateral-info",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetCollateralInfoAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetCollateralInfoResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetFeeRate
+// doc: https://bybit-exchange.github.io/docs/v5/account/fee-rate
+func (bb *BybitClient) GetFeeRate(ctx context.Context, param types.GetFeeRateParam) (*types.GetFeeRateResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/fee-rate",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFeeRateAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetFeeRateResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetAccountInfo
+// doc: https://bybit-exchange.github.io/docs/v5/account/account-info
+func (bb *BybitClient) GetAccountInfo(ctx context.Context) (*types.GetAccountInfoResp, error) {
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/info",
+		Method:  http.MethodGet,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetAccountInfoAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetAccountInfoResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetTransactionLog
+// doc: https://bybit-exchange.github.io/docs/v5/account/transaction-log
+func (bb *BybitClient) GetTransactionLog(ctx context.Context, param types.GetTransactionLogParam) (*types.GetTransactionLogResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/transaction-log",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetTransactionLogAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetTransactionLogResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// SetMarginMode
+// doc: https://bybit-exchange.github.io/docs/v5/account/set-margin-mode
+func (bb *BybitClient) SetMarginMode(ctx context.Context, param types.SetMarginModeParam) (*types.SetMarginModeResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/set-margin-mode",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.SetMarginModeAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.SetMarginModeResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// ModifyMMP configures market maker protection of options
+// doc: https://bybit-exchange.github.io/docs/v5/account/set-mmp
+func (bb *BybitClient) ModifyMMP(ctx context.Context, param types.ModifyMMPParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/mmp-modify",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// ResetMMP unfreezes market maker protection of options
+// doc: https://bybit-exchange.github.io/docs/v5/account/reset-mmp
+func (bb *BybitClient) ResetMMP(ctx context.Context, param types.ResetMMPParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/mmp-reset",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetMMPState
+// doc: https://bybit-exchange.github.io/docs/v5/account/get-mmp-state
+func (bb *BybitClient) GetMMPState(ctx context.Context, param types.GetMMPStateParam) (*types.GetMMPStateResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/account/mmp-state",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetMMPStateAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetMMPStateResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
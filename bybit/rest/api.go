@@ -28,7 +28,7 @@ import (
 // GetUnifiedAccountBalance(Unified Account API): UNIFIED (trade spot/linear/options)
 // Note: the Unified account supports inverse trading. However, the margin used is from the inverse derivatives wallet instead of the unified wallet.
 // doc: https://bybit-exchange.github.io/docs/v5/intro#current-api-coverage
-func (bb *BybitClient) GetUnifiedAccountBalance() (*types.GetWalletBalanceResp, error) {
+func (bb *BybitClient) GetUnifiedAccountBalance(ctx context.Context) (*types.GetWalletBalanceResp, error) {
 	req := utils.HTTPRequest{
 		BaseURL: bb.cli.GetBaseURL(),
 		Path:    "/v5/account/wallet-balance",
@@ -44,7 +44,7 @@ func (bb *BybitClient) GetUnifiedAccountBalance() (*types.GetWalletBalanceResp,
 	}
 	req.Headers = headers
 
-	resp, err := bb.cli.SendHTTPRequest(context.TODO(), req)
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +64,7 @@ func (bb *BybitClient) GetUnifiedAccountBalance() (*types.GetWalletBalanceResp,
 }
 
 // GetContractAccountBalance(Unified Account API): CONTRACT(trade inverse)
-func (bb *BybitClient) GetUnifiedAccountContractBalance() (*types.GetWalletBalanceResp, error) {
+func (bb *BybitClient) GetUnifiedAccountContractBalance(ctx context.Context) (*types.GetWalletBalanceResp, error) {
 	req := utils.HTTPRequest{
 		BaseURL: bb.cli.GetBaseURL(),
 		Path:    "/v5/account/wallet-balance",
@@ -80,7 +80,7 @@ func (bb *BybitClient) GetUnifiedAccountContractBalance() (*types.GetWalletBalan
 	}
 	req.Headers = headers
 
-	resp, err := bb.cli.SendHTTPRequest(context.TODO(), req)
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +100,7 @@ func (bb *BybitClient) GetUnifiedAccountContractBalance() (*types.GetWalletBalan
 }
 
 // GetFundAccountBalance get Funding wallet balance
-func (bb *BybitClient) GetFundAccountBalance() (*types.GetAccountBalanceResp, error) {
+func (bb *BybitClient) GetFundAccountBalance(ctx context.Context) (*types.GetAccountBalanceResp, error) {
 	req := utils.HTTPRequest{
 		BaseURL: bb.cli.GetBaseURL(),
 		Path:    "/v5/asset/transfer/query-account-coins-balance",
@@ -116,7 +116,7 @@ func (bb *BybitClient) GetFundAccountBalance() (*types.GetAccountBalanceResp, er
 	}
 	req.Headers = headers
 
-	resp, err := bb.cli.SendHTTPRequest(context.TODO(), req)
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
 	if err != nil {
 		return nil, err
 	}
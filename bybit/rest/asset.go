@@ -0,0 +1,546 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linstohu/nexapi/bybit/rest/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+// InternalTransfer transfers between the account types of the same uid
+// doc: https://bybit-exchange.github.io/docs/v5/asset/create-inter-transfer
+func (bb *BybitClient) InternalTransfer(ctx context.Context, param types.InternalTransferParam) (*types.TransferResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/transfer/inter-transfer",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.TransferAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.TransferResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetInternalTransfers
+// doc: https://bybit-exchange.github.io/docs/v5/asset/inter-transfer-list
+func (bb *BybitClient) GetInternalTransfers(ctx context.Context, param types.GetTransfersParam) (*types.GetInternalTransfersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/transfer/query-inter-transfer-list",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetInternalTransfersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetInternalTransfersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// UniversalTransfer transfers between the master account and sub-accounts, it needs a master account key
+// doc: https://bybit-exchange.github.io/docs/v5/asset/unitransfer
+func (bb *BybitClient) UniversalTransfer(ctx context.Context, param types.UniversalTransferParam) (*types.TransferResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/transfer/universal-transfer",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.TransferAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.TransferResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetUniversalTransfers
+// doc: https://bybit-exchange.github.io/docs/v5/asset/unitransfer-list
+func (bb *BybitClient) GetUniversalTransfers(ctx context.Context, param types.GetTransfersParam) (*types.GetUniversalTransfersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/transfer/query-universal-transfer-list",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetUniversalTransfersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetUniversalTransfersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetCoinInfo
+// doc: https://bybit-exchange.github.io/docs/v5/asset/coin-info
+func (bb *BybitClient) GetCoinInfo(ctx context.Context, param types.GetCoinInfoParam) (*types.GetCoinInfoResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/coin/query-info",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetCoinInfoAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetCoinInfoResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetDepositAddress
+// doc: https://bybit-exchange.github.io/docs/v5/asset/master-deposit-addr
+func (bb *BybitClient) GetDepositAddress(ctx context.Context, param types.GetDepositAddressParam) (*types.GetDepositAddressResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/deposit/query-address",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetDepositAddressAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetDepositAddressResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetDepositRecords
+// doc: https://bybit-exchange.github.io/docs/v5/asset/deposit-record
+func (bb *BybitClient) GetDepositRecords(ctx context.Context, param types.GetDepositRecordsParam) (*types.GetDepositRecordsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/deposit/query-record",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetDepositRecordsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetDepositRecordsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// Withdraw
+// doc: https://bybit-exchange.github.io/docs/v5/asset/withdraw
+func (bb *BybitClient) Withdraw(ctx context.Context, param types.WithdrawParam) (*types.WithdrawResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/withdraw/create",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.WithdrawAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.WithdrawResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetWithdrawRecords
+// doc: https://bybit-exchange.github.io/docs/v5/asset/withdraw-record
+func (bb *BybitClient) GetWithdrawRecords(ctx context.Context, param types.GetWithdrawRecordsParam) (*types.GetWithdrawRecordsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/withdraw/query-record",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetWithdrawRecordsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetWithdrawRecordsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// ConvertQuote requests a quote, confirm it with ConfirmConvert before it expires
+// doc: https://bybit-exchange.github.io/docs/v5/asset/convert/apply-quote
+func (bb *BybitClient) ConvertQuote(ctx context.Context, param types.ConvertQuoteParam) (*types.ConvertQuoteResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/exchange/quote-apply",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.ConvertQuoteAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.ConvertQuoteResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// ConfirmConvert
+// doc: https://bybit-exchange.github.io/docs/v5/asset/convert/confirm-quote
+func (bb *BybitClient) ConfirmConvert(ctx context.Context, param types.ConfirmConvertParam) (*types.ConfirmConvertResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/exchange/convert-execute",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.ConfirmConvertAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.ConfirmConvertResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetExchangeRecords
+// doc: https://bybit-exchange.github.io/docs/v5/asset/exchange
+func (bb *BybitClient) GetExchangeRecords(ctx context.Context, param types.GetExchangeRecordsParam) (*types.GetExchangeRecordsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/exchange/order-record",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetExchangeRecordsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetExchangeRecordsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetDeliveryRecords
+// doc: https://bybit-exchange.github.io/docs/v5/asset/delivery
+func (bb *BybitClient) GetDeliveryRecords(ctx context.Context, param types.GetDeliveryRecordsParam) (*types.GetDeliveryRecordsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/asset/delivery-record",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetDeliveryRecordsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetDeliveryRecordsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
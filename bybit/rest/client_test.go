@@ -18,10 +18,12 @@
 package rest
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/linstohu/nexapi/bybit/rest/types"
 	"github.com/linstohu/nexapi/bybit/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,7 +46,7 @@ func testNewClient(t *testing.T) *BybitClient {
 func TestGetUnifiedAccountBalance(t *testing.T) {
 	cli := testNewClient(t)
 
-	resp, err := cli.GetUnifiedAccountBalance()
+	resp, err := cli.GetUnifiedAccountBalance(context.TODO())
 	assert.Nil(t, err)
 
 	for _, v := range resp.Body.Result.List {
@@ -55,7 +57,7 @@ func TestGetUnifiedAccountBalance(t *testing.T) {
 func TestGetUnifiedAccountContractBalance(t *testing.T) {
 	cli := testNewClient(t)
 
-	resp, err := cli.GetUnifiedAccountContractBalance()
+	resp, err := cli.GetUnifiedAccountContractBalance(context.TODO())
 	assert.Nil(t, err)
 
 	for _, v := range resp.Body.Result.List {
@@ -66,10 +68,162 @@ func TestGetUnifiedAccountContractBalance(t *testing.T) {
 func TestGetFundAccountBalance(t *testing.T) {
 	cli := testNewClient(t)
 
-	resp, err := cli.GetFundAccountBalance()
+	resp, err := cli.GetFundAccountBalance(context.TODO())
 	assert.Nil(t, err)
 
 	for _, v := range resp.Body.Result.Balance {
 		fmt.Printf("%+v\n", v)
 	}
 }
+
+func TestGetOpenOrders(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetOpenOrders(context.TODO(), types.GetOpenOrdersParam{
+		Category: types.Linear,
+		Symbol:   "BTCUSDT",
+	})
+	assert.Nil(t, err)
+
+	for _, v := range resp.Body.Result.List {
+		fmt.Printf("%+v\n", v)
+	}
+}
+
+func TestPlaceAndCancelOrder(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.PlaceOrder(context.TODO(), types.PlaceOrderParam{
+		Category: types.Spot,
+		PlaceOrderRequest: types.PlaceOrderRequest{
+			Symbol:      "BTCUSDT",
+			Side:        "Buy",
+			OrderType:   "Limit",
+			Qty:         "0.001",
+			Price:       "10000",
+			TimeInForce: "PostOnly",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, resp.Body.RetCode)
+
+	_, err = cli.CancelOrder(context.TODO(), types.CancelOrderParam{
+		Category: types.Spot,
+		CancelOrderRequest: types.CancelOrderRequest{
+			Symbol:  "BTCUSDT",
+			OrderId: resp.Body.Result.OrderId,
+		},
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetKlines(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetKlines(context.TODO(), types.GetKlinesParam{
+		Category: types.Linear,
+		Symbol:   "BTCUSDT",
+		Interval: "60",
+		Limit:    10,
+	})
+	assert.Nil(t, err)
+
+	for _, v := range resp.Body.Result.List {
+		fmt.Printf("%+v\n", v)
+	}
+}
+
+func TestGetAllLinearInstruments(t *testing.T) {
+	cli := testNewClient(t)
+
+	list, err := cli.GetAllLinearInstruments(context.TODO(), types.GetInstrumentsParam{
+		Limit: 500,
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, list)
+}
+
+func TestGetSpotTickers(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetSpotTickers(context.TODO(), types.GetTickersParam{
+		Symbol: "BTCUSDT",
+	})
+	assert.Nil(t, err)
+
+	for _, v := range resp.Body.Result.List {
+		fmt.Printf("%+v\n", v)
+	}
+}
+
+func TestGetPositions(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetPositions(context.TODO(), types.GetPositionsParam{
+		Category:   types.Linear,
+		SettleCoin: "USDT",
+	})
+	assert.Nil(t, err)
+
+	for _, v := range resp.Body.Result.List {
+		fmt.Printf("%+v\n", v)
+	}
+}
+
+func TestGetAccountInfo(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetAccountInfo(context.TODO())
+	assert.Nil(t, err)
+
+	fmt.Printf("%+v\n", resp.Body.Result)
+}
+
+func TestGetFeeRate(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetFeeRate(context.TODO(), types.GetFeeRateParam{
+		Category: types.Spot,
+		Symbol:   "BTCUSDT",
+	})
+	assert.Nil(t, err)
+
+	for _, v := range resp.Body.Result.List {
+		fmt.Printf("%+v\n", v)
+	}
+}
+
+func TestGetCoinInfo(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetCoinInfo(context.TODO(), types.GetCoinInfoParam{
+		Coin: "USDT",
+	})
+	assert.Nil(t, err)
+
+	for _, v := range resp.Body.Result.Rows {
+		fmt.Printf("%+v\n", v)
+	}
+}
+
+func TestGetInternalTransfers(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetInternalTransfers(context.TODO(), types.GetTransfersParam{})
+	assert.Nil(t, err)
+
+	for _, v := range resp.Body.Result.List {
+		fmt.Printf("%+v\n", v)
+	}
+}
+
+func TestGetSubMembers(t *testing.T) {
+	cli := testNewClient(t)
+
+	resp, err := cli.GetSubMembers(context.TODO())
+	assert.Nil(t, err)
+
+	for _, v := range resp.Body.Result.SubMembers {
+		fmt.Printf("%+v\n", v)
+	}
+}
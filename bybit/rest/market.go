@@ -0,0 +1,877 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/linstohu/nexapi/bybit/rest/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type instrumentsQuery struct {
+	Category types.Category `url:"category"`
+	types.GetInstrumentsParam
+}
+
+type tickersQuery struct {
+	Category types.Category `url:"category"`
+	types.GetTickersParam
+}
+
+// GetServerTime
+// doc: https://bybit-exchange.github.io/docs/v5/market/time
+func (bb *BybitClient) GetServerTime(ctx context.Context) (*types.GetServerTimeResp, error) {
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/time",
+		Method:  http.MethodGet,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetServerTimeAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetServerTimeResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetKlines returns klines of spot, linear and inverse, sorted in reverse by startTime
+// doc: https://bybit-exchange.github.io/docs/v5/market/kline
+func (bb *BybitClient) GetKlines(ctx context.Context, param types.GetKlinesParam) (*types.GetKlinesResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/kline",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetKlinesAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetKlinesResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetMarkPriceKlines returns mark price klines of linear and inverse
+// doc: https://bybit-exchange.github.io/docs/v5/market/mark-kline
+func (bb *BybitClient) GetMarkPriceKlines(ctx context.Context, param types.GetKlinesParam) (*types.GetPriceKlinesResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/mark-price-kline",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPriceKlinesAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetPriceKlinesResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetIndexPriceKlines returns index price klines of linear and inverse
+// doc: https://bybit-exchange.github.io/docs/v5/market/index-kline
+func (bb *BybitClient) GetIndexPriceKlines(ctx context.Context, param types.GetKlinesParam) (*types.GetPriceKlinesResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/index-price-kline",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPriceKlinesAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetPriceKlinesResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetPremiumIndexPriceKlines returns premium index price klines of linear
+// doc: https://bybit-exchange.github.io/docs/v5/market/premium-index-kline
+func (bb *BybitClient) GetPremiumIndexPriceKlines(ctx context.Context, param types.GetKlinesParam) (*types.GetPriceKlinesResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/premium-index-price-kline",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPriceKlinesAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetPriceKlinesResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetSpotInstruments returns spot instruments
+// doc: https://bybit-exchange.github.io/docs/v5/market/instrument
+func (bb *BybitClient) GetSpotInstruments(ctx context.Context, param types.GetInstrumentsParam) (*types.GetSpotInstrumentsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/instruments-info",
+		Method:  http.MethodGet,
+		Query: instrumentsQuery{
+			Category:            types.Spot,
+			GetInstrumentsParam: param,
+		},
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetSpotInstrumentsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetSpotInstrumentsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetLinearInstruments returns USDT and USDC perpetual and futures instruments
+func (bb *BybitClient) GetLinearInstruments(ctx context.Context, param types.GetInstrumentsParam) (*types.GetFuturesInstrumentsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/instruments-info",
+		Method:  http.MethodGet,
+		Query: instrumentsQuery{
+			Category:            types.Linear,
+			GetInstrumentsParam: param,
+		},
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFuturesInstrumentsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetFuturesInstrumentsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetInverseInstruments returns inverse perpetual and futures instruments
+func (bb *BybitClient) GetInverseInstruments(ctx context.Context, param types.GetInstrumentsParam) (*types.GetFuturesInstrumentsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/instruments-info",
+		Method:  http.MethodGet,
+		Query: instrumentsQuery{
+			Category:            types.Inverse,
+			GetInstrumentsParam: param,
+		},
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFuturesInstrumentsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetFuturesInstrumentsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetOptionInstruments returns option instruments
+func (bb *BybitClient) GetOptionInstruments(ctx context.Context, param types.GetInstrumentsParam) (*types.GetOptionInstrumentsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/instruments-info",
+		Method:  http.MethodGet,
+		Query: instrumentsQuery{
+			Category:            types.Option,
+			GetInstrumentsParam: param,
+		},
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOptionInstrumentsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetOptionInstrumentsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetAllSpotInstruments follows nextPageCursor until all spot instruments are returned
+func (bb *BybitClient) GetAllSpotInstruments(ctx context.Context, param types.GetInstrumentsParam) ([]types.SpotInstrument, error) {
+	return walkCursor(ctx, param.Cursor, func(cursor string) ([]types.SpotInstrument, string, error) {
+		param.Cursor = cursor
+		resp, err := bb.GetSpotInstruments(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		if resp.Body.RetCode != 0 {
+			return nil, "", fmt.Errorf("retCode: %d, retMsg: %s", resp.Body.RetCode, resp.Body.RetMsg)
+		}
+		return resp.Body.Result.List, resp.Body.Result.NextPageCursor, nil
+	})
+}
+
+// GetAllLinearInstruments follows nextPageCursor until all linear instruments are returned
+func (bb *BybitClient) GetAllLinearInstruments(ctx context.Context, param types.GetInstrumentsParam) ([]types.FuturesInstrument, error) {
+	return walkCursor(ctx, param.Cursor, func(cursor string) ([]types.FuturesInstrument, string, error) {
+		param.Cursor = cursor
+		resp, err := bb.GetLinearInstruments(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		if resp.Body.RetCode != 0 {
+			return nil, "", fmt.Errorf("retCode: %d, retMsg: %s", resp.Body.RetCode, resp.Body.RetMsg)
+		}
+		return resp.Body.Result.List, resp.Body.Result.NextPageCursor, nil
+	})
+}
+
+// GetAllInverseInstruments follows nextPageCursor until all inverse instruments are returned
+func (bb *BybitClient) GetAllInverseInstruments(ctx context.Context, param types.GetInstrumentsParam) ([]types.FuturesInstrument, error) {
+	return walkCursor(ctx, param.Cursor, func(cursor string) ([]types.FuturesInstrument, string, error) {
+		param.Cursor = cursor
+		resp, err := bb.GetInverseInstruments(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		if resp.Body.RetCode != 0 {
+			return nil, "", fmt.Errorf("retCode: %d, retMsg: %s", resp.Body.RetCode, resp.Body.RetMsg)
+		}
+		return resp.Body.Result.List, resp.Body.Result.NextPageCursor, nil
+	})
+}
+
+// GetAllOptionInstruments follows nextPageCursor until all option instruments are returned
+func (bb *BybitClient) GetAllOptionInstruments(ctx context.Context, param types.GetInstrumentsParam) ([]types.OptionInstrument, error) {
+	return walkCursor(ctx, param.Cursor, func(cursor string) ([]types.OptionInstrument, string, error) {
+		param.Cursor = cursor
+		resp, err := bb.GetOptionInstruments(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		if resp.Body.RetCode != 0 {
+			return nil, "", fmt.Errorf("retCode: %d, retMsg: %s", resp.Body.RetCode, resp.Body.RetMsg)
+		}
+		return resp.Body.Result.List, resp.Body.Result.NextPageCursor, nil
+	})
+}
+
+// GetOrderBook
+// doc: https://bybit-exchange.github.io/docs/v5/market/orderbook
+func (bb *BybitClient) GetOrderBook(ctx context.Context, param types.GetOrderBookParam) (*types.GetOrderBookResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/orderbook",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrderBookAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetOrderBookResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetSpotTickers
+// doc: https://bybit-exchange.github.io/docs/v5/market/tickers
+func (bb *BybitClient) GetSpotTickers(ctx context.Context, param types.GetTickersParam) (*types.GetSpotTickersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/tickers",
+		Method:  http.MethodGet,
+		Query: tickersQuery{
+			Category:        types.Spot,
+			GetTickersParam: param,
+		},
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetSpotTickersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetSpotTickersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+func (bb *BybitClient) GetLinearTickers(ctx context.Context, param types.GetTickersParam) (*types.GetFuturesTickersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/tickers",
+		Method:  http.MethodGet,
+		Query: tickersQuery{
+			Category:        types.Linear,
+			GetTickersParam: param,
+		},
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFuturesTickersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetFuturesTickersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+func (bb *BybitClient) GetInverseTickers(ctx context.Context, param types.GetTickersParam) (*types.GetFuturesTickersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/tickers",
+		Method:  http.MethodGet,
+		Query: tickersQuery{
+			Category:        types.Inverse,
+			GetTickersParam: param,
+		},
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFuturesTickersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetFuturesTickersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetOptionTickers needs BaseCoin or Symbol
+func (bb *BybitClient) GetOptionTickers(ctx context.Context, param types.GetTickersParam) (*types.GetOptionTickersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/tickers",
+		Method:  http.MethodGet,
+		Query: tickersQuery{
+			Category:        types.Option,
+			GetTickersParam: param,
+		},
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOptionTickersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetOptionTickersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetFundingRateHistory
+// doc: https://bybit-exchange.github.io/docs/v5/market/history-fund-rate
+func (bb *BybitClient) GetFundingRateHistory(ctx context.Context, param types.GetFundingRateHistoryParam) (*types.GetFundingRateHistoryResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/funding/history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFundingRateHistoryAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetFundingRateHistoryResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetRecentTrades
+// doc: https://bybit-exchange.github.io/docs/v5/market/recent-trade
+func (bb *BybitClient) GetRecentTrades(ctx context.Context, param types.GetRecentTradesParam) (*types.GetRecentTradesResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/recent-trade",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetRecentTradesAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetRecentTradesResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetOpenInterest
+// doc: https://bybit-exchange.github.io/docs/v5/market/open-interest
+func (bb *BybitClient) GetOpenInterest(ctx context.Context, param types.GetOpenInterestParam) (*types.GetOpenInterestResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/open-interest",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOpenInterestAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetOpenInterestResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetHistoricalVolatility
+// doc: https://bybit-exchange.github.io/docs/v5/market/iv
+func (bb *BybitClient) GetHistoricalVolatility(ctx context.Context, param types.GetHistoricalVolatilityParam) (*types.GetHistoricalVolatilityResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/historical-volatility",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetHistoricalVolatilityAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetHistoricalVolatilityResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetInsurance
+// doc: https://bybit-exchange.github.io/docs/v5/market/insurance
+func (bb *BybitClient) GetInsurance(ctx context.Context, param types.GetInsuranceParam) (*types.GetInsuranceResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/insurance",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetInsuranceAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetInsuranceResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetRiskLimit
+// doc: https://bybit-exchange.github.io/docs/v5/market/risk-limit
+func (bb *BybitClient) GetRiskLimit(ctx context.Context, param types.GetRiskLimitParam) (*types.GetRiskLimitResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/risk-limit",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetRiskLimitAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetRiskLimitResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetDeliveryPrice
+// doc: https://bybit-exchange.github.io/docs/v5/market/delivery-price
+func (bb *BybitClient) GetDeliveryPrice(ctx context.Context, param types.GetDeliveryPriceParam) (*types.GetDeliveryPriceResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/delivery-price",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetDeliveryPriceAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetDeliveryPriceResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetAccountRatio returns the long/short ratio of accounts
+// doc: https://bybit-exchange.github.io/docs/v5/market/long-short-ratio
+func (bb *BybitClient) GetAccountRatio(ctx context.Context, param types.GetAccountRatioParam) (*types.GetAccountRatioResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/market/account-ratio",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetAccountRatioAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetAccountRatioResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// walkCursor calls fetch with the returned nextPageCursor until it is empty
+func walkCursor[T any](ctx context.Context, cursor string, fetch func(cursor string) ([]T, string, error)) ([]T, error) {
+	var all []T
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		list, next, err := fetch(cursor)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+
+		if next == "" || next == cursor {
+			return all, nil
+		}
+		cursor = next
+	}
+}
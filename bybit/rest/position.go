@@ -0,0 +1,426 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linstohu/nexapi/bybit/rest/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+// GetPositions returns real-time position data
+// doc: https://bybit-exchange.github.io/docs/v5/position
+func (bb *BybitClient) GetPositions(ctx context.Context, param types.GetPositionsParam) (*types.GetPositionsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/list",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPositionsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetPositionsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// SetLeverage
+// doc: https://bybit-exchange.github.io/docs/v5/position/leverage
+func (bb *BybitClient) SetLeverage(ctx context.Context, param types.SetLeverageParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/set-leverage",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// SwitchIsolated switches between cross margin and isolated margin
+// doc: https://bybit-exchange.github.io/docs/v5/position/cross-isolate
+func (bb *BybitClient) SwitchIsolated(ctx context.Context, param types.SwitchIsolatedParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/switch-isolated",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// SwitchPositionMode switches between one-way mode and hedge mode
+// doc: https://bybit-exchange.github.io/docs/v5/position/position-mode
+func (bb *BybitClient) SwitchPositionMode(ctx context.Context, param types.SwitchModeParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/switch-mode",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// SetTpSlMode
+// doc: https://bybit-exchange.github.io/docs/v5/position/tpsl-mode
+func (bb *BybitClient) SetTpSlMode(ctx context.Context, param types.SetTpSlModeParam) (*types.SetTpSlModeResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/set-tpsl-mode",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.SetTpSlModeAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.SetTpSlModeResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// SetTradingStop sets take profit, stop loss or trailing stop for a position
+// doc: https://bybit-exchange.github.io/docs/v5/position/trading-stop
+func (bb *BybitClient) SetTradingStop(ctx context.Context, param types.TradingStopParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/trading-stop",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// SetAutoAddMargin
+// doc: https://bybit-exchange.github.io/docs/v5/position/auto-add-margin
+func (bb *BybitClient) SetAutoAddMargin(ctx context.Context, param types.SetAutoAddMarginParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/set-auto-add-margin",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// AddMargin adds or reduces margin of an isolated position
+// doc: https://bybit-exchange.github.io/docs/v5/position/manual-add-margin
+func (bb *BybitClient) AddMargin(ctx context.Context, param types.AddMarginParam) (*types.AddMarginResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/add-margin",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.AddMarginAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.AddMarginResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetClosedPnl
+// doc: https://bybit-exchange.github.io/docs/v5/position/close-pnl
+func (bb *BybitClient) GetClosedPnl(ctx context.Context, param types.GetClosedPnlParam) (*types.GetClosedPnlResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/closed-pnl",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetClosedPnlAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetClosedPnlResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// MovePositions
+// doc: https://bybit-exchange.github.io/docs/v5/position/move-position
+func (bb *BybitClient) MovePositions(ctx context.Context, param types.MovePositionsParam) (*types.MovePositionsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/position/move-positions",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.MovePositionsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.MovePositionsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
@@ -0,0 +1,466 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linstohu/nexapi/bybit/rest/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+// PlaceOrder places an order of spot, linear, inverse or option
+// doc: https://bybit-exchange.github.io/docs/v5/order/create-order
+func (bb *BybitClient) PlaceOrder(ctx context.Context, param types.PlaceOrderParam) (*types.OrderResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/create",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.OrderAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.OrderResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// AmendOrder amends an unfilled or partially filled order
+// doc: https://bybit-exchange.github.io/docs/v5/order/amend-order
+func (bb *BybitClient) AmendOrder(ctx context.Context, param types.AmendOrderParam) (*types.OrderResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/amend",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.OrderAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.OrderResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// CancelOrder cancels an unfilled or partially filled order
+// doc: https://bybit-exchange.github.io/docs/v5/order/cancel-order
+func (bb *BybitClient) CancelOrder(ctx context.Context, param types.CancelOrderParam) (*types.OrderResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/cancel",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.OrderAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.OrderResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// CancelAllOrders cancels all open orders of a category, filtered by symbol, baseCoin or settleCoin
+// doc: https://bybit-exchange.github.io/docs/v5/order/cancel-all
+func (bb *BybitClient) CancelAllOrders(ctx context.Context, param types.CancelAllOrdersParam) (*types.CancelAllOrdersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/cancel-all",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.CancelAllOrdersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.CancelAllOrdersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// BatchPlaceOrders places up to 20 orders at a time, check RetExtInfo for the result of each order
+// doc: https://bybit-exchange.github.io/docs/v5/order/batch-place
+func (bb *BybitClient) BatchPlaceOrders(ctx context.Context, param types.BatchPlaceOrdersParam) (*types.BatchOrdersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/create-batch",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.BatchOrdersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.BatchOrdersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// BatchAmendOrders amends up to 20 orders at a time
+// doc: https://bybit-exchange.github.io/docs/v5/order/batch-amend
+func (bb *BybitClient) BatchAmendOrders(ctx context.Context, param types.BatchAmendOrdersParam) (*types.BatchOrdersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/amend-batch",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.BatchOrdersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.BatchOrdersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// BatchCancelOrders cancels up to 20 orders at a time
+// doc: https://bybit-exchange.github.io/docs/v5/order/batch-cancel
+func (bb *BybitClient) BatchCancelOrders(ctx context.Context, param types.BatchCancelOrdersParam) (*types.BatchOrdersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/cancel-batch",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.BatchOrdersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.BatchOrdersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetOpenOrders queries unfilled or partially filled orders in real-time
+// doc: https://bybit-exchange.github.io/docs/v5/order/open-order
+func (bb *BybitClient) GetOpenOrders(ctx context.Context, param types.GetOpenOrdersParam) (*types.GetOrdersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/realtime",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrdersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetOrdersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetOrderHistory queries orders of the last 2 years
+// doc: https://bybit-exchange.github.io/docs/v5/order/order-list
+func (bb *BybitClient) GetOrderHistory(ctx context.Context, param types.GetOrderHistoryParam) (*types.GetOrdersResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrdersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetOrdersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetExecutions queries the trade history
+// doc: https://bybit-exchange.github.io/docs/v5/order/execution
+func (bb *BybitClient) GetExecutions(ctx context.Context, param types.GetExecutionsParam) (*types.GetExecutionsResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/execution/list",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetExecutionsAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetExecutionsResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// SetDCP sets the disconnection protect time window, orders are cancelled when all private websockets disconnect
+// doc: https://bybit-exchange.github.io/docs/v5/order/dcp
+func (bb *BybitClient) SetDCP(ctx context.Context, param types.SetDCPParam) (*types.SetDCPResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/order/disconnected-cancel-all",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.SetDCPAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.SetDCPResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
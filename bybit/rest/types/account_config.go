@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "github.com/linstohu/nexapi/utils"
+
+type UpgradeToUTAResult struct {
+	UnifiedUpdateStatus string `json:"unifiedUpdateStatus"`
+	UnifiedUpdateMsg    struct {
+		Msg []string `json:"msg"`
+	} `json:"unifiedUpdateMsg"`
+}
+
+// GetBorrowHistoryParam
+// doc: https://bybit-exchange.github.io/docs/v5/account/borrow-history
+type GetBorrowHistoryParam struct {
+	Currency  string `url:"currency,omitempty"`
+	StartTime int64  `url:"startTime,omitempty"`
+	EndTime   int64  `url:"endTime,omitempty"`
+	Limit     int    `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor    string `url:"cursor,omitempty"`
+}
+
+type Borrow struct {
+	Currency                  string `json:"currency"`
+	CreatedTime               int64  `json:"createdTime"`
+	BorrowCost                string `json:"borrowCost"`
+	HourlyBorrowRate          string `json:"hourlyBorrowRate"`
+	InterestBearingBorrowSize string `json:"InterestBearingBorrowSize"`
+	CostExemption             string `json:"costExemption"`
+	BorrowAmount              string `json:"borrowAmount"`
+	UnrealisedLoss            string `json:"unrealisedLoss"`
+	FreeBorrowedAmount        string `json:"freeBorrowedAmount"`
+}
+
+type GetCollateralInfoParam struct {
+	Currency string `url:"currency,omitempty"`
+}
+
+// CollateralInfo
+// doc: https://bybit-exchange.github.io/docs/v5/account/collateral-info
+type CollateralInfo struct {
+	Currency            string `json:"currency"`
+	HourlyBorrowRate    string `json:"hourlyBorrowRate"`
+	MaxBorrowingAmount  string `json:"maxBorrowingAmount"`
+	FreeBorrowingLimit  string `json:"freeBorrowingLimit"`
+	FreeBorrowAmount    string `json:"freeBorrowAmount"`
+	BorrowAmount        string `json:"borrowAmount"`
+	OtherBorrowAmount   string `json:"otherBorrowAmount"`
+	AvailableToBorrow   string `json:"availableToBorrow"`
+	Borrowable          bool   `json:"borrowable"`
+	FreeBorrowingAmount string `json:"freeBorrowingAmount"`
+	BorrowUsageRate     string `json:"borrowUsageRate"`
+	MarginCollateral    bool   `json:"marginCollateral"`
+	CollateralSwitch    bool   `json:"collateralSwitch"`
+	CollateralRatio     string `json:"collateralRatio"`
+}
+
+// GetFeeRateParam
+// doc: https://bybit-exchange.github.io/docs/v5/account/fee-rate
+type GetFeeRateParam struct {
+	Category Category `url:"category" validate:"required,oneof=spot linear inverse option"`
+	Symbol   string   `url:"symbol,omitempty"`
+	BaseCoin string   `url:"baseCoin,omitempty"`
+}
+
+type FeeRate struct {
+	Symbol       string `json:"symbol"`
+	BaseCoin     string `json:"baseCoin"`
+	TakerFeeRate string `json:"takerFeeRate"`
+	MakerFeeRate string `json:"makerFeeRate"`
+}
+
+// AccountInfo
+// doc: https://bybit-exchange.github.io/docs/v5/account/account-info
+type AccountInfo struct {
+	UnifiedMarginStatus int    `json:"unifiedMarginStatus"`
+	MarginMode          string `json:"marginMode"`
+	IsMasterTrader      bool   `json:"isMasterTrader"`
+	SpotHedgingStatus   string `json:"spotHedgingStatus"`
+	UpdatedTime         string `json:"updatedTime"`
+	DcpStatus           string `json:"dcpStatus"`
+	TimeWindow          int    `json:"timeWindow"`
+	SmpGroup            int    `json:"smpGroup"`
+}
+
+// GetTransactionLogParam
+// doc: https://bybit-exchange.github.io/docs/v5/account/transaction-log
+type GetTransactionLogParam struct {
+	AccountType AccountType `url:"accountType,omitempty" validate:"omitempty,oneof=UNIFIED"`
+	Category    Category    `url:"category,omitempty" validate:"omitempty,oneof=spot linear inverse option"`
+	Currency    string      `url:"currency,omitempty"`
+	BaseCoin    string      `url:"baseCoin,omitempty"`
+	Type        string      `url:"type,omitempty"`
+	StartTime   int64       `url:"startTime,omitempty"`
+	EndTime     int64       `url:"endTime,omitempty"`
+	Limit       int         `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor      string      `url:"cursor,omitempty"`
+}
+
+type TransactionLog struct {
+	Id              string `json:"id"`
+	Symbol          string `json:"symbol"`
+	Side            string `json:"side"`
+	Funding         string `json:"funding"`
+	OrderLinkId     string `json:"orderLinkId"`
+	OrderId         string `json:"orderId"`
+	Fee             string `json:"fee"`
+	Change          string `json:"change"`
+	CashFlow        string `json:"cashFlow"`
+	TransactionTime string `json:"transactionTime"`
+	Type            string `json:"type"`
+	FeeRate         string `json:"feeRate"`
+	BonusChange     string `json:"bonusChange"`
+	Size            string `json:"size"`
+	Qty             string `json:"qty"`
+	CashBalance     string `json:"cashBalance"`
+	Currency        string `json:"currency"`
+	Category        string `json:"category"`
+	TradePrice      string `json:"tradePrice"`
+	TradeId         string `json:"tradeId"`
+}
+
+// SetMarginModeParam
+// doc: https://bybit-exchange.github.io/docs/v5/account/set-margin-mode
+type SetMarginModeParam struct {
+	SetMarginMode string `json:"setMarginMode" validate:"required,oneof=ISOLATED_MARGIN REGULAR_MARGIN PORTFOLIO_MARGIN"`
+}
+
+type SetMarginModeResult struct {
+	Reasons []struct {
+		ReasonCode string `json:"reasonCode"`
+		ReasonMsg  string `json:"reasonMsg"`
+	} `json:"reasons"`
+}
+
+// ModifyMMPParam configures market maker protection of options
+// doc: https://bybit-exchange.github.io/docs/v5/account/set-mmp
+type ModifyMMPParam struct {
+	BaseCoin     string `json:"baseCoin" validate:"required"`
+	Window       string `json:"window" validate:"required"`
+	FrozenPeriod string `json:"frozenPeriod" validate:"required"`
+	QtyLimit     string `json:"qtyLimit" validate:"required"`
+	DeltaLimit   string `json:"deltaLimit" validate:"required"`
+}
+
+// ResetMMPParam unfreezes market maker protection of options
+// doc: https://bybit-exchange.github.io/docs/v5/account/reset-mmp
+type ResetMMPParam struct {
+	BaseCoin string `json:"baseCoin" validate:"required"`
+}
+
+type GetMMPStateParam struct {
+	BaseCoin string `url:"baseCoin" validate:"required"`
+}
+
+type MMPState struct {
+	BaseCoin       string `json:"baseCoin"`
+	MmpEnabled     bool   `json:"mmpEnabled"`
+	Window         string `json:"window"`
+	FrozenPeriod   string `json:"frozenPeriod"`
+	QtyLimit       string `json:"qtyLimit"`
+	DeltaLimit     string `json:"deltaLimit"`
+	MmpFrozenUntil string `json:"mmpFrozenUntil"`
+	MmpFrozen      bool   `json:"mmpFrozen"`
+}
+
+type UpgradeToUTAResp struct {
+	Http *utils.ApiResponse
+	Body *UpgradeToUTAAPIResp
+}
+
+type UpgradeToUTAAPIResp struct {
+	Response `json:",inline"`
+	Result   UpgradeToUTAResult `json:"result"`
+}
+
+type GetBorrowHistoryResp struct {
+	Http *utils.ApiResponse
+	Body *GetBorrowHistoryAPIResp
+}
+
+type GetBorrowHistoryAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[Borrow] `json:"result"`
+}
+
+type GetCollateralInfoResp struct {
+	Http *utils.ApiResponse
+	Body *GetCollateralInfoAPIResp
+}
+
+type GetCollateralInfoAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[CollateralInfo] `json:"result"`
+}
+
+type GetFeeRateResp struct {
+	Http *utils.ApiResponse
+	Body *GetFeeRateAPIResp
+}
+
+type GetFeeRateAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[FeeRate] `json:"result"`
+}
+
+type GetAccountInfoResp struct {
+	Http *utils.ApiResponse
+	Body *GetAccountInfoAPIResp
+}
+
+type GetAccountInfoAPIResp struct {
+	Response `json:",inline"`
+	Result   AccountInfo `json:"result"`
+}
+
+type GetTransactionLogResp struct {
+	Http *utils.ApiResponse
+	Body *GetTransactionLogAPIResp
+}
+
+type GetTransactionLogAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[TransactionLog] `json:"result"`
+}
+
+type SetMarginModeResp struct {
+	Http *utils.ApiResponse
+	Body *SetMarginModeAPIResp
+}
+
+type SetMarginModeAPIResp struct {
+	Response `json:",inline"`
+	Result   SetMarginModeResult `json:"result"`
+}
+
+type GetMMPStateResp struct {
+	Http *utils.ApiResponse
+	Body *GetMMPStateAPIResp
+}
+
+type GetMMPStateAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[MMPState] `json:"result"`
+}
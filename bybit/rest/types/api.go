@@ -17,9 +17,21 @@
 
 package types
 
+import "github.com/linstohu/nexapi/utils"
+
 type Response struct {
 	RetCode    int         `json:"retCode"`
 	RetMsg     string      `json:"retMsg"`
 	RetExtInfo interface{} `json:"retExtInfo"`
 	Time       int64       `json:"time"`
 }
+
+// EmptyResp is returned by endpoints whose result is empty
+type EmptyResp struct {
+	Http *utils.ApiResponse
+	Body *EmptyAPIResp
+}
+
+type EmptyAPIResp struct {
+	Response `json:",inline"`
+}
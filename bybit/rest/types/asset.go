@@ -0,0 +1,431 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "github.com/linstohu/nexapi/utils"
+
+// InternalTransferParam transfers between the account types of the same uid, TransferId is a UUID generated by the caller
+// doc: https://bybit-exchange.github.io/docs/v5/asset/create-inter-transfer
+type InternalTransferParam struct {
+	TransferId      string      `json:"transferId" validate:"required,uuid"`
+	Coin            string      `json:"coin" validate:"required"`
+	Amount          string      `json:"amount" validate:"required"`
+	FromAccountType AccountType `json:"fromAccountType" validate:"required"`
+	ToAccountType   AccountType `json:"toAccountType" validate:"required"`
+}
+
+// UniversalTransferParam transfers between the master account and sub-accounts, or between sub-accounts
+// doc: https://bybit-exchange.github.io/docs/v5/asset/unitransfer
+type UniversalTransferParam struct {
+	TransferId      string      `json:"transferId" validate:"required,uuid"`
+	Coin            string      `json:"coin" validate:"required"`
+	Amount          string      `json:"amount" validate:"required"`
+	FromMemberId    int64       `json:"fromMemberId" validate:"required"`
+	ToMemberId      int64       `json:"toMemberId" validate:"required"`
+	FromAccountType AccountType `json:"fromAccountType" validate:"required"`
+	ToAccountType   AccountType `json:"toAccountType" validate:"required"`
+}
+
+type TransferResult struct {
+	TransferId string `json:"transferId"`
+	Status     string `json:"status"`
+}
+
+// GetTransfersParam, the time range is at most 7 days and the last 7 days by default
+// doc: https://bybit-exchange.github.io/docs/v5/asset/inter-transfer-list
+type GetTransfersParam struct {
+	TransferId string `url:"transferId,omitempty"`
+	Coin       string `url:"coin,omitempty"`
+	Status     string `url:"status,omitempty" validate:"omitempty,oneof=SUCCESS PENDING FAILED"`
+	StartTime  int64  `url:"startTime,omitempty"`
+	EndTime    int64  `url:"endTime,omitempty"`
+	Limit      int    `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor     string `url:"cursor,omitempty"`
+}
+
+type TransferList[T any] struct {
+	List           []T    `json:"list"`
+	NextPageCursor string `json:"nextPageCursor"`
+}
+
+type InternalTransfer struct {
+	TransferId      string `json:"transferId"`
+	Coin            string `json:"coin"`
+	Amount          string `json:"amount"`
+	FromAccountType string `json:"fromAccountType"`
+	ToAccountType   string `json:"toAccountType"`
+	Timestamp       string `json:"timestamp"`
+	Status          string `json:"status"`
+}
+
+type UniversalTransfer struct {
+	TransferId      string `json:"transferId"`
+	Coin            string `json:"coin"`
+	Amount          string `json:"amount"`
+	FromMemberId    string `json:"fromMemberId"`
+	ToMemberId      string `json:"toMemberId"`
+	FromAccountType string `json:"fromAccountType"`
+	ToAccountType   string `json:"toAccountType"`
+	Timestamp       string `json:"timestamp"`
+	Status          string `json:"status"`
+}
+
+// GetCoinInfoParam returns all coins when Coin is empty
+// doc: https://bybit-exchange.github.io/docs/v5/asset/coin-info
+type GetCoinInfoParam struct {
+	Coin string `url:"coin,omitempty"`
+}
+
+type CoinInfoResult struct {
+	Rows []CoinInfo `json:"rows"`
+}
+
+type CoinInfo struct {
+	Name         string      `json:"name"`
+	Coin         string      `json:"coin"`
+	RemainAmount string      `json:"remainAmount"`
+	Chains       []CoinChain `json:"chains"`
+}
+
+type CoinChain struct {
+	Chain                 string `json:"chain"`
+	ChainType             string `json:"chainType"`
+	Confirmation          string `json:"confirmation"`
+	WithdrawFee           string `json:"withdrawFee"`
+	DepositMin            string `json:"depositMin"`
+	WithdrawMin           string `json:"withdrawMin"`
+	MinAccuracy           string `json:"minAccuracy"`
+	ChainDeposit          string `json:"chainDeposit"`
+	ChainWithdraw         string `json:"chainWithdraw"`
+	WithdrawPercentageFee string `json:"withdrawPercentageFee"`
+	ContractAddress       string `json:"contractAddress"`
+}
+
+// GetDepositAddressParam
+// doc: https://bybit-exchange.github.io/docs/v5/asset/master-deposit-addr
+type GetDepositAddressParam struct {
+	Coin      string `url:"coin" validate:"required"`
+	ChainType string `url:"chainType,omitempty"`
+}
+
+type DepositAddressResult struct {
+	Coin   string           `json:"coin"`
+	Chains []DepositAddress `json:"chains"`
+}
+
+type DepositAddress struct {
+	ChainType         string `json:"chainType"`
+	AddressDeposit    string `json:"addressDeposit"`
+	TagDeposit        string `json:"tagDeposit"`
+	Chain             string `json:"chain"`
+	BatchReleaseLimit string `json:"batchReleaseLimit"`
+	ContractAddress   string `json:"contractAddress"`
+}
+
+// GetDepositRecordsParam, the time range is at most 30 days and the last 30 days by default
+// doc: https://bybit-exchange.github.io/docs/v5/asset/deposit-record
+type GetDepositRecordsParam struct {
+	Id        string `url:"id,omitempty"`
+	TxID      string `url:"txID,omitempty"`
+	Coin      string `url:"coin,omitempty"`
+	StartTime int64  `url:"startTime,omitempty"`
+	EndTime   int64  `url:"endTime,omitempty"`
+	Limit     int    `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor    string `url:"cursor,omitempty"`
+}
+
+type DepositRecordsResult struct {
+	Rows           []DepositRecord `json:"rows"`
+	NextPageCursor string          `json:"nextPageCursor"`
+}
+
+type DepositRecord struct {
+	Id                string `json:"id"`
+	Coin              string `json:"coin"`
+	Chain             string `json:"chain"`
+	Amount            string `json:"amount"`
+	TxID              string `json:"txID"`
+	Status            int    `json:"status"`
+	ToAddress         string `json:"toAddress"`
+	Tag               string `json:"tag"`
+	DepositFee        string `json:"depositFee"`
+	SuccessAt         string `json:"successAt"`
+	Confirmations     string `json:"confirmations"`
+	TxIndex           string `json:"txIndex"`
+	BlockHash         string `json:"blockHash"`
+	BatchReleaseLimit string `json:"batchReleaseLimit"`
+	DepositType       string `json:"depositType"`
+}
+
+// WithdrawParam, Timestamp is the current time in milliseconds, the address must be in the whitelist
+// doc: https://bybit-exchange.github.io/docs/v5/asset/withdraw
+type WithdrawParam struct {
+	Coin        string      `json:"coin" validate:"required"`
+	Chain       string      `json:"chain,omitempty"`
+	Address     string      `json:"address" validate:"required"`
+	Tag         string      `json:"tag,omitempty"`
+	Amount      string      `json:"amount" validate:"required"`
+	Timestamp   int64       `json:"timestamp" validate:"required"`
+	ForceChain  int         `json:"forceChain,omitempty" validate:"omitempty,oneof=0 1 2"`
+	AccountType AccountType `json:"accountType,omitempty" validate:"omitempty,oneof=SPOT FUND"`
+	FeeType     int         `json:"feeType,omitempty" validate:"omitempty,oneof=0 1"`
+	RequestId   string      `json:"requestId,omitempty"`
+}
+
+type WithdrawResult struct {
+	Id string `json:"id"`
+}
+
+// GetWithdrawRecordsParam, WithdrawType is 0 for on chain, 1 for off chain and 2 for all
+// doc: https://bybit-exchange.github.io/docs/v5/asset/withdraw-record
+type GetWithdrawRecordsParam struct {
+	WithdrawID   string `url:"withdrawID,omitempty"`
+	TxID         string `url:"txID,omitempty"`
+	Coin         string `url:"coin,omitempty"`
+	WithdrawType int    `url:"withdrawType,omitempty" validate:"omitempty,oneof=0 1 2"`
+	StartTime    int64  `url:"startTime,omitempty"`
+	EndTime      int64  `url:"endTime,omitempty"`
+	Limit        int    `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor       string `url:"cursor,omitempty"`
+}
+
+type WithdrawRecordsResult struct {
+	Rows           []WithdrawRecord `json:"rows"`
+	NextPageCursor string           `json:"nextPageCursor"`
+}
+
+type WithdrawRecord struct {
+	WithdrawId   string `json:"withdrawId"`
+	TxID         string `json:"txID"`
+	WithdrawType int    `json:"withdrawType"`
+	Coin         string `json:"coin"`
+	Chain        string `json:"chain"`
+	Amount       string `json:"amount"`
+	WithdrawFee  string `json:"withdrawFee"`
+	Status       string `json:"status"`
+	ToAddress    string `json:"toAddress"`
+	Tag          string `json:"tag"`
+	CreateTime   string `json:"createTime"`
+	UpdateTime   string `json:"updateTime"`
+}
+
+// ConvertQuoteParam requests a quote, RequestCoin is either FromCoin or ToCoin
+// doc: https://bybit-exchange.github.io/docs/v5/asset/convert/apply-quote
+type ConvertQuoteParam struct {
+	AccountType   AccountType `json:"accountType" validate:"required,oneof=eb_convert_funding eb_convert_uta eb_convert_spot eb_convert_contract eb_convert_inverse"`
+	FromCoin      string      `json:"fromCoin" validate:"required"`
+	ToCoin        string      `json:"toCoin" validate:"required"`
+	RequestCoin   string      `json:"requestCoin" validate:"required"`
+	RequestAmount string      `json:"requestAmount" validate:"required"`
+	FromCoinType  string      `json:"fromCoinType,omitempty"`
+	ToCoinType    string      `json:"toCoinType,omitempty"`
+	ParamType     string      `json:"paramType,omitempty"`
+	ParamValue    string      `json:"paramValue,omitempty"`
+	RequestId     string      `json:"requestId,omitempty"`
+}
+
+type ConvertQuote struct {
+	QuoteTxId    string `json:"quoteTxId"`
+	ExchangeRate string `json:"exchangeRate"`
+	FromCoin     string `json:"fromCoin"`
+	FromCoinType string `json:"fromCoinType"`
+	ToCoin       string `json:"toCoin"`
+	ToCoinType   string `json:"toCoinType"`
+	FromAmount   string `json:"fromAmount"`
+	ToAmount     string `json:"toAmount"`
+	ExpiredTime  string `json:"expiredTime"`
+	RequestId    string `json:"requestId"`
+}
+
+// ConfirmConvertParam confirms a quote before it expires
+// doc: https://bybit-exchange.github.io/docs/v5/asset/convert/confirm-quote
+type ConfirmConvertParam struct {
+	QuoteTxId string `json:"quoteTxId" validate:"required"`
+}
+
+type ConfirmConvertResult struct {
+	QuoteTxId      string `json:"quoteTxId"`
+	ExchangeStatus string `json:"exchangeStatus"`
+}
+
+// GetExchangeRecordsParam
+// doc: https://bybit-exchange.github.io/docs/v5/asset/exchange
+type GetExchangeRecordsParam struct {
+	FromCoin string `url:"fromCoin,omitempty"`
+	ToCoin   string `url:"toCoin,omitempty"`
+	Limit    int    `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor   string `url:"cursor,omitempty"`
+}
+
+type ExchangeRecordsResult struct {
+	OrderBody      []ExchangeRecord `json:"orderBody"`
+	NextPageCursor string           `json:"nextPageCursor"`
+}
+
+type ExchangeRecord struct {
+	FromCoin     string `json:"fromCoin"`
+	FromAmount   string `json:"fromAmount"`
+	ToCoin       string `json:"toCoin"`
+	ToAmount     string `json:"toAmount"`
+	ExchangeRate string `json:"exchangeRate"`
+	CreatedTime  string `json:"createdTime"`
+	ExchangeTxId string `json:"exchangeTxId"`
+}
+
+// GetDeliveryRecordsParam
+// doc: https://bybit-exchange.github.io/docs/v5/asset/delivery
+type GetDeliveryRecordsParam struct {
+	Category  Category `url:"category" validate:"required,oneof=linear inverse option"`
+	Symbol    string   `url:"symbol,omitempty"`
+	StartTime int64    `url:"startTime,omitempty"`
+	EndTime   int64    `url:"endTime,omitempty"`
+	ExpDate   string   `url:"expDate,omitempty"`
+	Limit     int      `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor    string   `url:"cursor,omitempty"`
+}
+
+type DeliveryRecord struct {
+	DeliveryTime  int64  `json:"deliveryTime"`
+	Symbol        string `json:"symbol"`
+	Side          string `json:"side"`
+	Position      string `json:"position"`
+	DeliveryPrice string `json:"deliveryPrice"`
+	Strike        string `json:"strike"`
+	Fee           string `json:"fee"`
+	DeliveryRpl   string `json:"deliveryRpl"`
+}
+
+type TransferResp struct {
+	Http *utils.ApiResponse
+	Body *TransferAPIResp
+}
+
+type TransferAPIResp struct {
+	Response `json:",inline"`
+	Result   TransferResult `json:"result"`
+}
+
+type GetInternalTransfersResp struct {
+	Http *utils.ApiResponse
+	Body *GetInternalTransfersAPIResp
+}
+
+type GetInternalTransfersAPIResp struct {
+	Response `json:",inline"`
+	Result   TransferList[InternalTransfer] `json:"result"`
+}
+
+type GetUniversalTransfersResp struct {
+	Http *utils.ApiResponse
+	Body *GetUniversalTransfersAPIResp
+}
+
+type GetUniversalTransfersAPIResp struct {
+	Response `json:",inline"`
+	Result   TransferList[UniversalTransfer] `json:"result"`
+}
+
+type GetCoinInfoResp struct {
+	Http *utils.ApiResponse
+	Body *GetCoinInfoAPIResp
+}
+
+type GetCoinInfoAPIResp struct {
+	Response `json:",inline"`
+	Result   CoinInfoResult `json:"result"`
+}
+
+type GetDepositAddressResp struct {
+	Http *utils.ApiResponse
+	Body *GetDepositAddressAPIResp
+}
+
+type GetDepositAddressAPIResp struct {
+	Response `json:",inline"`
+	Result   DepositAddressResult `json:"result"`
+}
+
+type GetDepositRecordsResp struct {
+	Http *utils.ApiResponse
+	Body *GetDepositRecordsAPIResp
+}
+
+type GetDepositRecordsAPIResp struct {
+	Response `json:",inline"`
+	Result   DepositRecordsResult `json:"result"`
+}
+
+type WithdrawResp struct {
+	Http *utils.ApiResponse
+	Body *WithdrawAPIResp
+}
+
+type WithdrawAPIResp struct {
+	Response `json:",inline"`
+	Result   WithdrawResult `json:"result"`
+}
+
+type GetWithdrawRecordsResp struct {
+	Http *utils.ApiResponse
+	Body *GetWithdrawRecordsAPIResp
+}
+
+type GetWithdrawRecordsAPIResp struct {
+	Response `json:",inline"`
+	Result   WithdrawRecordsResult `json:"result"`
+}
+
+type ConvertQuoteResp struct {
+	Http *utils.ApiResponse
+	Body *ConvertQuoteAPIResp
+}
+
+type ConvertQuoteAPIResp struct {
+	Response `json:",inline"`
+	Result   ConvertQuote `json:"result"`
+}
+
+type ConfirmConvertResp struct {
+	Http *utils.ApiResponse
+	Body *ConfirmConvertAPIResp
+}
+
+type ConfirmConvertAPIResp struct {
+	Response `json:",inline"`
+	Result   ConfirmConvertResult `json:"result"`
+}
+
+type GetExchangeRecordsResp struct {
+	Http *utils.ApiResponse
+	Body *GetExchangeRecordsAPIResp
+}
+
+type GetExchangeRecordsAPIResp struct {
+	Response `json:",inline"`
+	Result   ExchangeRecordsResult `json:"result"`
+}
+
+type GetDeliveryRecordsResp struct {
+	Http *utils.ApiResponse
+	Body *GetDeliveryRecordsAPIResp
+}
+
+type GetDeliveryRecordsAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[DeliveryRecord] `json:"result"`
+}
@@ -0,0 +1,629 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/linstohu/nexapi/utils"
+)
+
+// ListResult is the result of endpoints which return a list, NextPageCursor is set when the endpoint is paginated
+type ListResult[T any] struct {
+	Category       string `json:"category"`
+	NextPageCursor string `json:"nextPageCursor"`
+	List           []T    `json:"list"`
+}
+
+type ServerTime struct {
+	TimeSecond string `json:"timeSecond"`
+	TimeNano   string `json:"timeNano"`
+}
+
+// GetKlinesParam, Category is only used by the kline endpoint, the price kline endpoints only support linear and inverse
+// doc: https://bybit-exchange.github.io/docs/v5/market/kline
+type GetKlinesParam struct {
+	Category Category `url:"category,omitempty" validate:"omitempty,oneof=spot linear inverse"`
+	Symbol   string   `url:"symbol" validate:"required"`
+	Interval string   `url:"interval" validate:"required,oneof=1 3 5 15 30 60 120 240 360 720 D M W"`
+	Start    int64    `url:"start,omitempty"`
+	End      int64    `url:"end,omitempty"`
+	Limit    int      `url:"limit,omitempty" validate:"omitempty,max=1000"`
+}
+
+type KlinesResult struct {
+	Category string  `json:"category"`
+	Symbol   string  `json:"symbol"`
+	List     []Kline `json:"list"`
+}
+
+// Kline is returned as [startTime, openPrice, highPrice, lowPrice, closePrice, volume, turnover]
+type Kline struct {
+	StartTime string
+	Open      string
+	High      string
+	Low       string
+	Close     string
+	Volume    string
+	Turnover  string
+}
+
+func (k *Kline) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalArray(data, 7)
+	if err != nil {
+		return err
+	}
+
+	k.StartTime, k.Open, k.High, k.Low, k.Close, k.Volume, k.Turnover = v[0], v[1], v[2], v[3], v[4], v[5], v[6]
+
+	return nil
+}
+
+type PriceKlinesResult struct {
+	Category string       `json:"category"`
+	Symbol   string       `json:"symbol"`
+	List     []PriceKline `json:"list"`
+}
+
+// PriceKline is returned by mark price, index price and premium index price kline endpoints as [startTime, openPrice, highPrice, lowPrice, closePrice]
+type PriceKline struct {
+	StartTime string
+	Open      string
+	High      string
+	Low       string
+	Close     string
+}
+
+func (k *PriceKline) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalArray(data, 5)
+	if err != nil {
+		return err
+	}
+
+	k.StartTime, k.Open, k.High, k.Low, k.Close = v[0], v[1], v[2], v[3], v[4]
+
+	return nil
+}
+
+func unmarshalArray(data []byte, n int) ([]string, error) {
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	if len(v) < n {
+		return nil, fmt.Errorf("invalid array: %s", string(data))
+	}
+
+	return v, nil
+}
+
+// GetInstrumentsParam, the category is set by the method
+// doc: https://bybit-exchange.github.io/docs/v5/market/instrument
+type GetInstrumentsParam struct {
+	Symbol   string `url:"symbol,omitempty"`
+	Status   string `url:"status,omitempty"`
+	BaseCoin string `url:"baseCoin,omitempty"`
+	Limit    int    `url:"limit,omitempty" validate:"omitempty,max=1000"`
+	Cursor   string `url:"cursor,omitempty"`
+}
+
+type SpotInstrument struct {
+	Symbol        string `json:"symbol"`
+	BaseCoin      string `json:"baseCoin"`
+	QuoteCoin     string `json:"quoteCoin"`
+	Innovation    string `json:"innovation"`
+	Status        string `json:"status"`
+	MarginTrading string `json:"marginTrading"`
+	LotSizeFilter struct {
+		BasePrecision  string `json:"basePrecision"`
+		QuotePrecision string `json:"quotePrecision"`
+		MinOrderQty    string `json:"minOrderQty"`
+		MaxOrderQty    string `json:"maxOrderQty"`
+		MinOrderAmt    string `json:"minOrderAmt"`
+		MaxOrderAmt    string `json:"maxOrderAmt"`
+	} `json:"lotSizeFilter"`
+	PriceFilter struct {
+		TickSize string `json:"tickSize"`
+	} `json:"priceFilter"`
+}
+
+// FuturesInstrument is returned for linear and inverse
+type FuturesInstrument struct {
+	Symbol          string `json:"symbol"`
+	ContractType    string `json:"contractType"`
+	Status          string `json:"status"`
+	BaseCoin        string `json:"baseCoin"`
+	QuoteCoin       string `json:"quoteCoin"`
+	SettleCoin      string `json:"settleCoin"`
+	LaunchTime      string `json:"launchTime"`
+	DeliveryTime    string `json:"deliveryTime"`
+	DeliveryFeeRate string `json:"deliveryFeeRate"`
+	PriceScale      string `json:"priceScale"`
+	LeverageFilter  struct {
+		MinLeverage  string `json:"minLeverage"`
+		MaxLeverage  string `json:"maxLeverage"`
+		LeverageStep string `json:"leverageStep"`
+	} `json:"leverageFilter"`
+	PriceFilter struct {
+		MinPrice string `json:"minPrice"`
+		MaxPrice string `json:"maxPrice"`
+		TickSize string `json:"tickSize"`
+	} `json:"priceFilter"`
+	LotSizeFilter struct {
+		MaxOrderQty         string `json:"maxOrderQty"`
+		MinOrderQty         string `json:"minOrderQty"`
+		QtyStep             string `json:"qtyStep"`
+		PostOnlyMaxOrderQty string `json:"postOnlyMaxOrderQty"`
+	} `json:"lotSizeFilter"`
+	UnifiedMarginTrade bool   `json:"unifiedMarginTrade"`
+	FundingInterval    int    `json:"fundingInterval"`
+	CopyTrading        string `json:"copyTrading"`
+	UpperFundingRate   string `json:"upperFundingRate"`
+	LowerFundingRate   string `json:"lowerFundingRate"`
+}
+
+type OptionInstrument struct {
+	Symbol          string `json:"symbol"`
+	OptionsType     string `json:"optionsType"`
+	Status          string `json:"status"`
+	BaseCoin        string `json:"baseCoin"`
+	QuoteCoin       string `json:"quoteCoin"`
+	SettleCoin      string `json:"settleCoin"`
+	LaunchTime      string `json:"launchTime"`
+	DeliveryTime    string `json:"deliveryTime"`
+	DeliveryFeeRate string `json:"deliveryFeeRate"`
+	PriceFilter     struct {
+		MinPrice string `json:"minPrice"`
+		MaxPrice string `json:"maxPrice"`
+		TickSize string `json:"tickSize"`
+	} `json:"priceFilter"`
+	LotSizeFilter struct {
+		MaxOrderQty string `json:"maxOrderQty"`
+		MinOrderQty string `json:"minOrderQty"`
+		QtyStep     string `json:"qtyStep"`
+	} `json:"lotSizeFilter"`
+}
+
+// GetOrderBookParam, Limit is up to 200 for spot, 500 for linear and inverse, 25 for option
+// doc: https://bybit-exchange.github.io/docs/v5/market/orderbook
+type GetOrderBookParam struct {
+	Category Category `url:"category" validate:"required,oneof=spot linear inverse option"`
+	Symbol   string   `url:"symbol" validate:"required"`
+	Limit    int      `url:"limit,omitempty" validate:"omitempty,max=500"`
+}
+
+// OrderBook, each level is [price, size]
+type OrderBook struct {
+	Symbol string     `json:"s"`
+	Bids   [][]string `json:"b"`
+	Asks   [][]string `json:"a"`
+	Ts     int64      `json:"ts"`
+	U      int64      `json:"u"`
+	Seq    int64      `json:"seq"`
+	Cts    int64      `json:"cts"`
+}
+
+// GetTickersParam, the category is set by the method, ExpDate is only used by option, e.g. 25DEC22
+// doc: https://bybit-exchange.github.io/docs/v5/market/tickers
+type GetTickersParam struct {
+	Symbol   string `url:"symbol,omitempty"`
+	BaseCoin string `url:"baseCoin,omitempty"`
+	ExpDate  string `url:"expDate,omitempty"`
+}
+
+type TickersResult[T any] struct {
+	Category string `json:"category"`
+	List     []T    `json:"list"`
+}
+
+type SpotTicker struct {
+	Symbol        string `json:"symbol"`
+	Bid1Price     string `json:"bid1Price"`
+	Bid1Size      string `json:"bid1Size"`
+	Ask1Price     string `json:"ask1Price"`
+	Ask1Size      string `json:"ask1Size"`
+	LastPrice     string `json:"lastPrice"`
+	PrevPrice24H  string `json:"prevPrice24h"`
+	Price24HPcnt  string `json:"price24hPcnt"`
+	HighPrice24H  string `json:"highPrice24h"`
+	LowPrice24H   string `json:"lowPrice24h"`
+	Turnover24H   string `json:"turnover24h"`
+	Volume24H     string `json:"volume24h"`
+	UsdIndexPrice string `json:"usdIndexPrice"`
+}
+
+// FuturesTicker is returned for linear and inverse
+type FuturesTicker struct {
+	Symbol                 string `json:"symbol"`
+	LastPrice              string `json:"lastPrice"`
+	IndexPrice             string `json:"indexPrice"`
+	MarkPrice              string `json:"markPrice"`
+	PrevPrice24H           string `json:"prevPrice24h"`
+	Price24HPcnt           string `json:"price24hPcnt"`
+	HighPrice24H           string `json:"highPrice24h"`
+	LowPrice24H            string `json:"lowPrice24h"`
+	PrevPrice1H            string `json:"prevPrice1h"`
+	OpenInterest           string `json:"openInterest"`
+	OpenInterestValue      string `json:"openInterestValue"`
+	Turnover24H            string `json:"turnover24h"`
+	Volume24H              string `json:"volume24h"`
+	FundingRate            string `json:"fundingRate"`
+	NextFundingTime        string `json:"nextFundingTime"`
+	PredictedDeliveryPrice string `json:"predictedDeliveryPrice"`
+	BasisRate              string `json:"basisRate"`
+	Basis                  string `json:"basis"`
+	DeliveryFeeRate        string `json:"deliveryFeeRate"`
+	DeliveryTime           string `json:"deliveryTime"`
+	Bid1Price              string `json:"bid1Price"`
+	Bid1Size               string `json:"bid1Size"`
+	Ask1Price              string `json:"ask1Price"`
+	Ask1Size               string `json:"ask1Size"`
+}
+
+type OptionTicker struct {
+	Symbol                 string `json:"symbol"`
+	Bid1Price              string `json:"bid1Price"`
+	Bid1Size               string `json:"bid1Size"`
+	Bid1Iv                 string `json:"bid1Iv"`
+	Ask1Price              string `json:"ask1Price"`
+	Ask1Size               string `json:"ask1Size"`
+	Ask1Iv                 string `json:"ask1Iv"`
+	LastPrice              string `json:"lastPrice"`
+	HighPrice24H           string `json:"highPrice24h"`
+	LowPrice24H            string `json:"lowPrice24h"`
+	MarkPrice              string `json:"markPrice"`
+	IndexPrice             string `json:"indexPrice"`
+	MarkIv                 string `json:"markIv"`
+	UnderlyingPrice        string `json:"underlyingPrice"`
+	OpenInterest           string `json:"openInterest"`
+	Turnover24H            string `json:"turnover24h"`
+	Volume24H              string `json:"volume24h"`
+	TotalVolume            string `json:"totalVolume"`
+	TotalTurnover          string `json:"totalTurnover"`
+	Delta                  string `json:"delta"`
+	Gamma                  string `json:"gamma"`
+	Vega                   string `json:"vega"`
+	Theta                  string `json:"theta"`
+	PredictedDeliveryPrice string `json:"predictedDeliveryPrice"`
+	Change24H              string `json:"change24h"`
+}
+
+// GetFundingRateHistoryParam
+// doc: https://bybit-exchange.github.io/docs/v5/market/history-fund-rate
+type GetFundingRateHistoryParam struct {
+	Category  Category `url:"category" validate:"required,oneof=linear inverse"`
+	Symbol    string   `url:"symbol" validate:"required"`
+	StartTime int64    `url:"startTime,omitempty"`
+	EndTime   int64    `url:"endTime,omitempty"`
+	Limit     int      `url:"limit,omitempty" validate:"omitempty,max=200"`
+}
+
+type FundingRate struct {
+	Symbol               string `json:"symbol"`
+	FundingRate          string `json:"fundingRate"`
+	FundingRateTimestamp string `json:"fundingRateTimestamp"`
+}
+
+// GetRecentTradesParam
+// doc: https://bybit-exchange.github.io/docs/v5/market/recent-trade
+type GetRecentTradesParam struct {
+	Category   Category `url:"category" validate:"required,oneof=spot linear inverse option"`
+	Symbol     string   `url:"symbol,omitempty"`
+	BaseCoin   string   `url:"baseCoin,omitempty"`
+	OptionType string   `url:"optionType,omitempty" validate:"omitempty,oneof=Call Put"`
+	Limit      int      `url:"limit,omitempty" validate:"omitempty,max=1000"`
+}
+
+type PublicTrade struct {
+	ExecId       string `json:"execId"`
+	Symbol       string `json:"symbol"`
+	Price        string `json:"price"`
+	Size         string `json:"size"`
+	Side         string `json:"side"`
+	Time         string `json:"time"`
+	IsBlockTrade bool   `json:"isBlockTrade"`
+	MP           string `json:"mP"`
+	IP           string `json:"iP"`
+	MIv          string `json:"mIv"`
+	Iv           string `json:"iv"`
+}
+
+// GetOpenInterestParam
+// doc: https://bybit-exchange.github.io/docs/v5/market/open-interest
+type GetOpenInterestParam struct {
+	Category     Category `url:"category" validate:"required,oneof=linear inverse"`
+	Symbol       string   `url:"symbol" validate:"required"`
+	IntervalTime string   `url:"intervalTime" validate:"required,oneof=5min 15min 30min 1h 4h 1d"`
+	StartTime    int64    `url:"startTime,omitempty"`
+	EndTime      int64    `url:"endTime,omitempty"`
+	Limit        int      `url:"limit,omitempty" validate:"omitempty,max=200"`
+	Cursor       string   `url:"cursor,omitempty"`
+}
+
+type OpenInterestResult struct {
+	Category       string `json:"category"`
+	Symbol         string `json:"symbol"`
+	NextPageCursor string `json:"nextPageCursor"`
+	List           []struct {
+		OpenInterest string `json:"openInterest"`
+		Timestamp    string `json:"timestamp"`
+	} `json:"list"`
+}
+
+// GetHistoricalVolatilityParam, Period is in days
+// doc: https://bybit-exchange.github.io/docs/v5/market/iv
+type GetHistoricalVolatilityParam struct {
+	Category  Category `url:"category" validate:"required,oneof=option"`
+	BaseCoin  string   `url:"baseCoin,omitempty"`
+	Period    int      `url:"period,omitempty" validate:"omitempty,oneof=7 14 21 30 60 90 180 270"`
+	StartTime int64    `url:"startTime,omitempty"`
+	EndTime   int64    `url:"endTime,omitempty"`
+}
+
+type HistoricalVolatility struct {
+	Period int    `json:"period"`
+	Value  string `json:"value"`
+	Time   string `json:"time"`
+}
+
+type GetInsuranceParam struct {
+	Coin string `url:"coin,omitempty"`
+}
+
+type InsuranceResult struct {
+	UpdatedTime string `json:"updatedTime"`
+	List        []struct {
+		Coin    string `json:"coin"`
+		Balance string `json:"balance"`
+		Value   string `json:"value"`
+	} `json:"list"`
+}
+
+// GetRiskLimitParam
+// doc: https://bybit-exchange.github.io/docs/v5/market/risk-limit
+type GetRiskLimitParam struct {
+	Category Category `url:"category" validate:"required,oneof=linear inverse"`
+	Symbol   string   `url:"symbol,omitempty"`
+	Cursor   string   `url:"cursor,omitempty"`
+}
+
+type RiskLimit struct {
+	Id                int    `json:"id"`
+	Symbol            string `json:"symbol"`
+	RiskLimitValue    string `json:"riskLimitValue"`
+	MaintenanceMargin string `json:"maintenanceMargin"`
+	InitialMargin     string `json:"initialMargin"`
+	IsLowestRisk      int    `json:"isLowestRisk"`
+	MaxLeverage       string `json:"maxLeverage"`
+}
+
+// GetDeliveryPriceParam
+// doc: https://bybit-exchange.github.io/docs/v5/market/delivery-price
+type GetDeliveryPriceParam struct {
+	Category Category `url:"category" validate:"required,oneof=linear inverse option"`
+	Symbol   string   `url:"symbol,omitempty"`
+	BaseCoin string   `url:"baseCoin,omitempty"`
+	Limit    int      `url:"limit,omitempty" validate:"omitempty,max=200"`
+	Cursor   string   `url:"cursor,omitempty"`
+}
+
+type DeliveryPrice struct {
+	Symbol        string `json:"symbol"`
+	DeliveryPrice string `json:"deliveryPrice"`
+	DeliveryTime  string `json:"deliveryTime"`
+}
+
+// GetAccountRatioParam returns the long/short ratio of accounts
+// doc: https://bybit-exchange.github.io/docs/v5/market/long-short-ratio
+type GetAccountRatioParam struct {
+	Category  Category `url:"category" validate:"required,oneof=linear inverse"`
+	Symbol    string   `url:"symbol" validate:"required"`
+	Period    string   `url:"period" validate:"required,oneof=5min 15min 30min 1h 4h 1d"`
+	StartTime int64    `url:"startTime,omitempty"`
+	EndTime   int64    `url:"endTime,omitempty"`
+	Limit     int      `url:"limit,omitempty" validate:"omitempty,max=500"`
+}
+
+type AccountRatio struct {
+	Symbol    string `json:"symbol"`
+	BuyRatio  string `json:"buyRatio"`
+	SellRatio string `json:"sellRatio"`
+	Timestamp string `json:"timestamp"`
+}
+
+type GetServerTimeResp struct {
+	Http *utils.ApiResponse
+	Body *GetServerTimeAPIResp
+}
+
+type GetServerTimeAPIResp struct {
+	Response `json:",inline"`
+	Result   ServerTime `json:"result"`
+}
+
+type GetKlinesResp struct {
+	Http *utils.ApiResponse
+	Body *GetKlinesAPIResp
+}
+
+type GetKlinesAPIResp struct {
+	Response `json:",inline"`
+	Result   KlinesResult `json:"result"`
+}
+
+type GetPriceKlinesResp struct {
+	Http *utils.ApiResponse
+	Body *GetPriceKlinesAPIResp
+}
+
+type GetPriceKlinesAPIResp struct {
+	Response `json:",inline"`
+	Result   PriceKlinesResult `json:"result"`
+}
+
+type GetSpotInstrumentsResp struct {
+	Http *utils.ApiResponse
+	Body *GetSpotInstrumentsAPIResp
+}
+
+type GetSpotInstrumentsAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[SpotInstrument] `json:"result"`
+}
+
+type GetFuturesInstrumentsResp struct {
+	Http *utils.ApiResponse
+	Body *GetFuturesInstrumentsAPIResp
+}
+
+type GetFuturesInstrumentsAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[FuturesInstrument] `json:"result"`
+}
+
+type GetOptionInstrumentsResp struct {
+	Http *utils.ApiResponse
+	Body *GetOptionInstrumentsAPIResp
+}
+
+type GetOptionInstrumentsAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[OptionInstrument] `json:"result"`
+}
+
+type GetOrderBookResp struct {
+	Http *utils.ApiResponse
+	Body *GetOrderBookAPIResp
+}
+
+type GetOrderBookAPIResp struct {
+	Response `json:",inline"`
+	Result   OrderBook `json:"result"`
+}
+
+type GetSpotTickersResp struct {
+	Http *utils.ApiResponse
+	Body *GetSpotTickersAPIResp
+}
+
+type GetSpotTickersAPIResp struct {
+	Response `json:",inline"`
+	Result   TickersResult[SpotTicker] `json:"result"`
+}
+
+type GetFuturesTickersResp struct {
+	Http *utils.ApiResponse
+	Body *GetFuturesTickersAPIResp
+}
+
+type GetFuturesTickersAPIResp struct {
+	Response `json:",inline"`
+	Result   TickersResult[FuturesTicker] `json:"result"`
+}
+
+type GetOptionTickersResp struct {
+	Http *utils.ApiResponse
+	Body *GetOptionTickersAPIResp
+}
+
+type GetOptionTickersAPIResp struct {
+	Response `json:",inline"`
+	Result   TickersResult[OptionTicker] `json:"result"`
+}
+
+type GetFundingRateHistoryResp struct {
+	Http *utils.ApiResponse
+	Body *GetFundingRateHistoryAPIResp
+}
+
+type GetFundingRateHistoryAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[FundingRate] `json:"result"`
+}
+
+type GetRecentTradesResp struct {
+	Http *utils.ApiResponse
+	Body *GetRecentTradesAPIResp
+}
+
+type GetRecentTradesAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[PublicTrade] `json:"result"`
+}
+
+type GetOpenInterestResp struct {
+	Http *utils.ApiResponse
+	Body *GetOpenInterestAPIResp
+}
+
+type GetOpenInterestAPIResp struct {
+	Response `json:",inline"`
+	Result   OpenInterestResult `json:"result"`
+}
+
+type GetHistoricalVolatilityResp struct {
+	Http *utils.ApiResponse
+	Body *GetHistoricalVolatilityAPIResp
+}
+
+type GetHistoricalVolatilityAPIResp struct {
+	Response `json:",inline"`
+	Result   []HistoricalVolatility `json:"result"`
+}
+
+type GetInsuranceResp struct {
+	Http *utils.ApiResponse
+	Body *GetInsuranceAPIResp
+}
+
+type GetInsuranceAPIResp struct {
+	Response `json:",inline"`
+	Result   InsuranceResult `json:"result"`
+}
+
+type GetRiskLimitResp struct {
+	Http *utils.ApiResponse
+	Body *GetRiskLimitAPIResp
+}
+
+type GetRiskLimitAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[RiskLimit] `json:"result"`
+}
+
+type GetDeliveryPriceResp struct {
+	Http *utils.ApiResponse
+	Body *GetDeliveryPriceAPIResp
+}
+
+type GetDeliveryPriceAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[DeliveryPrice] `json:"result"`
+}
+
+type GetAccountRatioResp struct {
+	Http *utils.ApiResponse
+	Body *GetAccountRatioAPIResp
+}
+
+type GetAccountRatioAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[AccountRatio] `json:"result"`
+}
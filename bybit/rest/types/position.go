@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "github.com/linstohu/nexapi/utils"
+
+// GetPositionsParam, Symbol, BaseCoin or SettleCoin is needed for linear and inverse
+// doc: https://bybit-exchange.github.io/docs/v5/position
+type GetPositionsParam struct {
+	Category   Category `url:"category" validate:"required,oneof=linear inverse option"`
+	Symbol     string   `url:"symbol,omitempty"`
+	BaseCoin   string   `url:"baseCoin,omitempty"`
+	SettleCoin string   `url:"settleCoin,omitempty"`
+	Limit      int      `url:"limit,omitempty" validate:"omitempty,max=200"`
+	Cursor     string   `url:"cursor,omitempty"`
+}
+
+type Position struct {
+	PositionIdx            int    `json:"positionIdx"`
+	RiskId                 int    `json:"riskId"`
+	RiskLimitValue         string `json:"riskLimitValue"`
+	Symbol                 string `json:"symbol"`
+	Side                   string `json:"side"`
+	Size                   string `json:"size"`
+	AvgPrice               string `json:"avgPrice"`
+	PositionValue          string `json:"positionValue"`
+	TradeMode              int    `json:"tradeMode"`
+	AutoAddMargin          int    `json:"autoAddMargin"`
+	PositionStatus         string `json:"positionStatus"`
+	Leverage               string `json:"leverage"`
+	MarkPrice              string `json:"markPrice"`
+	LiqPrice               string `json:"liqPrice"`
+	BustPrice              string `json:"bustPrice"`
+	PositionIM             string `json:"positionIM"`
+	PositionMM             string `json:"positionMM"`
+	PositionBalance        string `json:"positionBalance"`
+	TpslMode               string `json:"tpslMode"`
+	TakeProfit             string `json:"takeProfit"`
+	StopLoss               string `json:"stopLoss"`
+	TrailingStop           string `json:"trailingStop"`
+	SessionAvgPrice        string `json:"sessionAvgPrice"`
+	Delta                  string `json:"delta"`
+	Gamma                  string `json:"gamma"`
+	Vega                   string `json:"vega"`
+	Theta                  string `json:"theta"`
+	UnrealisedPnl          string `json:"unrealisedPnl"`
+	CurRealisedPnl         string `json:"curRealisedPnl"`
+	CumRealisedPnl         string `json:"cumRealisedPnl"`
+	AdlRankIndicator       int    `json:"adlRankIndicator"`
+	IsReduceOnly           bool   `json:"isReduceOnly"`
+	MmrSysUpdatedTime      string `json:"mmrSysUpdatedTime"`
+	LeverageSysUpdatedTime string `json:"leverageSysUpdatedTime"`
+	CreatedTime            string `json:"createdTime"`
+	UpdatedTime            string `json:"updatedTime"`
+	Seq                    int64  `json:"seq"`
+}
+
+// SetLeverageParam
+// doc: https://bybit-exchange.github.io/docs/v5/position/leverage
+type SetLeverageParam struct {
+	Category     Category `json:"category" validate:"required,oneof=linear inverse"`
+	Symbol       string   `json:"symbol" validate:"required"`
+	BuyLeverage  string   `json:"buyLeverage" validate:"required"`
+	SellLeverage string   `json:"sellLeverage" validate:"required"`
+}
+
+// SwitchIsolatedParam, TradeMode 0 is cross margin and 1 is isolated margin
+// doc: https://bybit-exchange.github.io/docs/v5/position/cross-isolate
+type SwitchIsolatedParam struct {
+	Category     Category `json:"category" validate:"required,oneof=linear inverse"`
+	Symbol       string   `json:"symbol" validate:"required"`
+	TradeMode    int      `json:"tradeMode" validate:"oneof=0 1"`
+	BuyLeverage  string   `json:"buyLeverage" validate:"required"`
+	SellLeverage string   `json:"sellLeverage" validate:"required"`
+}
+
+// SwitchModeParam, Mode 0 is merged single position and 3 is both sides
+// doc: https://bybit-exchange.github.io/docs/v5/position/position-mode
+type SwitchModeParam struct {
+	Category Category `json:"category" validate:"required,oneof=linear inverse"`
+	Symbol   string   `json:"symbol,omitempty" validate:"required_without=Coin"`
+	Coin     string   `json:"coin,omitempty"`
+	Mode     int      `json:"mode" validate:"oneof=0 3"`
+}
+
+// SetTpSlModeParam
+// doc: https://bybit-exchange.github.io/docs/v5/position/tpsl-mode
+type SetTpSlModeParam struct {
+	Category Category `json:"category" validate:"required,oneof=linear inverse"`
+	Symbol   string   `json:"symbol" validate:"required"`
+	TpSlMode string   `json:"tpSlMode" validate:"required,oneof=Full Partial"`
+}
+
+// TradingStopParam sets take profit, stop loss or trailing stop for a position
+// doc: https://bybit-exchange.github.io/docs/v5/position/trading-stop
+type TradingStopParam struct {
+	Category     Category `json:"category" validate:"required,oneof=linear inverse"`
+	Symbol       string   `json:"symbol" validate:"required"`
+	TakeProfit   string   `json:"takeProfit,omitempty"`
+	StopLoss     string   `json:"stopLoss,omitempty"`
+	TrailingStop string   `json:"trailingStop,omitempty"`
+	TpTriggerBy  string   `json:"tpTriggerBy,omitempty" validate:"omitempty,oneof=LastPrice IndexPrice MarkPrice"`
+	SlTriggerBy  string   `json:"slTriggerBy,omitempty" validate:"omitempty,oneof=LastPrice IndexPrice MarkPrice"`
+	ActivePrice  string   `json:"activePrice,omitempty"`
+	TpslMode     string   `json:"tpslMode,omitempty" validate:"omitempty,oneof=Full Partial"`
+	TpSize       string   `json:"tpSize,omitempty"`
+	SlSize       string   `json:"slSize,omitempty"`
+	TpLimitPrice string   `json:"tpLimitPrice,omitempty"`
+	SlLimitPrice string   `json:"slLimitPrice,omitempty"`
+	TpOrderType  string   `json:"tpOrderType,omitempty" validate:"omitempty,oneof=Market Limit"`
+	SlOrderType  string   `json:"slOrderType,omitempty" validate:"omitempty,oneof=Market Limit"`
+	PositionIdx  int      `json:"positionIdx" validate:"oneof=0 1 2"`
+}
+
+// SetAutoAddMarginParam
+// doc: https://bybit-exchange.github.io/docs/v5/position/auto-add-margin
+type SetAutoAddMarginParam struct {
+	Category      Category `json:"category" validate:"required,oneof=linear"`
+	Symbol        string   `json:"symbol" validate:"required"`
+	AutoAddMargin int      `json:"autoAddMargin" validate:"oneof=0 1"`
+	PositionIdx   int      `json:"positionIdx,omitempty" validate:"omitempty,oneof=0 1 2"`
+}
+
+// AddMarginParam adds or reduces the margin of an isolated position, a negative Margin reduces it
+// doc: https://bybit-exchange.github.io/docs/v5/position/manual-add-margin
+type AddMarginParam struct {
+	Category    Category `json:"category" validate:"required,oneof=linear inverse"`
+	Symbol      string   `json:"symbol" validate:"required"`
+	Margin      string   `json:"margin" validate:"required"`
+	PositionIdx int      `json:"positionIdx,omitempty" validate:"omitempty,oneof=0 1 2"`
+}
+
+// GetClosedPnlParam
+// doc: https://bybit-exchange.github.io/docs/v5/position/close-pnl
+type GetClosedPnlParam struct {
+	Category  Category `url:"category" validate:"required,oneof=linear inverse"`
+	Symbol    string   `url:"symbol,omitempty"`
+	StartTime int64    `url:"startTime,omitempty"`
+	EndTime   int64    `url:"endTime,omitempty"`
+	Limit     int      `url:"limit,omitempty" validate:"omitempty,max=100"`
+	Cursor    string   `url:"cursor,omitempty"`
+}
+
+type ClosedPnl struct {
+	Symbol        string `json:"symbol"`
+	OrderId       string `json:"orderId"`
+	Side          string `json:"side"`
+	Qty           string `json:"qty"`
+	OrderPrice    string `json:"orderPrice"`
+	OrderType     string `json:"orderType"`
+	ExecType      string `json:"execType"`
+	ClosedSize    string `json:"closedSize"`
+	CumEntryValue string `json:"cumEntryValue"`
+	AvgEntryPrice string `json:"avgEntryPrice"`
+	CumExitValue  string `json:"cumExitValue"`
+	AvgExitPrice  string `json:"avgExitPrice"`
+	ClosedPnl     string `json:"closedPnl"`
+	FillCount     string `json:"fillCount"`
+	Leverage      string `json:"leverage"`
+	CreatedTime   string `json:"createdTime"`
+	UpdatedTime   string `json:"updatedTime"`
+}
+
+// MovePositionsParam moves positions between the master account and sub-accounts
+// doc: https://bybit-exchange.github.io/docs/v5/position/move-position
+type MovePositionsParam struct {
+	FromUid string         `json:"fromUid" validate:"required"`
+	ToUid   string         `json:"toUid" validate:"required"`
+	List    []MovePosition `json:"list" validate:"required,min=1,max=25,dive"`
+}
+
+type MovePosition struct {
+	Category Category `json:"category" validate:"required,oneof=spot linear option"`
+	Symbol   string   `json:"symbol" validate:"required"`
+	Price    string   `json:"price" validate:"required"`
+	Side     string   `json:"side" validate:"required,oneof=Buy Sell"`
+	Qty      string   `json:"qty" validate:"required"`
+}
+
+type MovePositionsResult struct {
+	BlockTradeId string `json:"blockTradeId"`
+	Status       string `json:"status"`
+	RejectParty  string `json:"rejectParty"`
+}
+
+type GetPositionsResp struct {
+	Http *utils.ApiResponse
+	Body *GetPositionsAPIResp
+}
+
+type GetPositionsAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[Position] `json:"result"`
+}
+
+type SetTpSlModeResp struct {
+	Http *utils.ApiResponse
+	Body *SetTpSlModeAPIResp
+}
+
+type SetTpSlModeAPIResp struct {
+	Response `json:",inline"`
+	Result   SetTpSlModeParam `json:"result"`
+}
+
+type AddMarginResp struct {
+	Http *utils.ApiResponse
+	Body *AddMarginAPIResp
+}
+
+type AddMarginAPIResp struct {
+	Response `json:",inline"`
+	Result   Position `json:"result"`
+}
+
+type GetClosedPnlResp struct {
+	Http *utils.ApiResponse
+	Body *GetClosedPnlAPIResp
+}
+
+type GetClosedPnlAPIResp struct {
+	Response `json:",inline"`
+	Result   ListResult[ClosedPnl] `json:"result"`
+}
+
+type MovePositionsResp struct {
+	Http *utils.ApiResponse
+	Body *MovePositionsAPIResp
+}
+
+type MovePositionsAPIResp struct {
+	Response `json:",inline"`
+	Result   MovePositionsResult `json:"result"`
+}
@@ -0,0 +1,368 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "github.com/linstohu/nexapi/utils"
+
+// Category
+// doc: https://bybit-exchange.github.io/docs/v5/enum#category
+type Category = string
+
+const (
+	Spot    = "spot"
+	Linear  = "linear"
+	Inverse = "inverse"
+	Option  = "option"
+)
+
+type PlaceOrderParam struct {
+	Category Category `json:"category" validate:"required,oneof=spot linear inverse option"`
+	PlaceOrderRequest
+}
+
+// PlaceOrderRequest is the order of PlaceOrderParam and BatchPlaceOrdersParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/create-order
+type PlaceOrderRequest struct {
+	Symbol           string `json:"symbol" validate:"required"`
+	IsLeverage       int    `json:"isLeverage,omitempty" validate:"omitempty,oneof=0 1"`
+	Side             string `json:"side" validate:"required,oneof=Buy Sell"`
+	OrderType        string `json:"orderType" validate:"required,oneof=Market Limit"`
+	Qty              string `json:"qty" validate:"required"`
+	MarketUnit       string `json:"marketUnit,omitempty" validate:"omitempty,oneof=baseCoin quoteCoin"`
+	Price            string `json:"price,omitempty"`
+	TriggerDirection int    `json:"triggerDirection,omitempty" validate:"omitempty,oneof=1 2"`
+	OrderFilter      string `json:"orderFilter,omitempty" validate:"omitempty,oneof=Order tpslOrder StopOrder"`
+	TriggerPrice     string `json:"triggerPrice,omitempty"`
+	TriggerBy        string `json:"triggerBy,omitempty" validate:"omitempty,oneof=LastPrice IndexPrice MarkPrice"`
+	OrderIv          string `json:"orderIv,omitempty"`
+	TimeInForce      string `json:"timeInForce,omitempty" validate:"omitempty,oneof=GTC IOC FOK PostOnly"`
+	PositionIdx      int    `json:"positionIdx,omitempty" validate:"omitempty,oneof=0 1 2"`
+	OrderLinkId      string `json:"orderLinkId,omitempty"`
+	TakeProfit       string `json:"takeProfit,omitempty"`
+	StopLoss         string `json:"stopLoss,omitempty"`
+	TpTriggerBy      string `json:"tpTriggerBy,omitempty" validate:"omitempty,oneof=LastPrice IndexPrice MarkPrice"`
+	SlTriggerBy      string `json:"slTriggerBy,omitempty" validate:"omitempty,oneof=LastPrice IndexPrice MarkPrice"`
+	ReduceOnly       bool   `json:"reduceOnly,omitempty"`
+	CloseOnTrigger   bool   `json:"closeOnTrigger,omitempty"`
+	SmpType          string `json:"smpType,omitempty"`
+	Mmp              bool   `json:"mmp,omitempty"`
+	TpslMode         string `json:"tpslMode,omitempty" validate:"omitempty,oneof=Full Partial"`
+	TpLimitPrice     string `json:"tpLimitPrice,omitempty"`
+	SlLimitPrice     string `json:"slLimitPrice,omitempty"`
+	TpOrderType      string `json:"tpOrderType,omitempty" validate:"omitempty,oneof=Market Limit"`
+	SlOrderType      string `json:"slOrderType,omitempty" validate:"omitempty,oneof=Market Limit"`
+}
+
+type AmendOrderParam struct {
+	Category Category `json:"category" validate:"required,oneof=spot linear inverse option"`
+	AmendOrderRequest
+}
+
+// AmendOrderRequest is the order of AmendOrderParam and BatchAmendOrdersParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/amend-order
+type AmendOrderRequest struct {
+	Symbol       string `json:"symbol" validate:"required"`
+	OrderId      string `json:"orderId,omitempty" validate:"required_without=OrderLinkId"`
+	OrderLinkId  string `json:"orderLinkId,omitempty"`
+	OrderIv      string `json:"orderIv,omitempty"`
+	TriggerPrice string `json:"triggerPrice,omitempty"`
+	Qty          string `json:"qty,omitempty"`
+	Price        string `json:"price,omitempty"`
+	TpslMode     string `json:"tpslMode,omitempty" validate:"omitempty,oneof=Full Partial"`
+	TakeProfit   string `json:"takeProfit,omitempty"`
+	StopLoss     string `json:"stopLoss,omitempty"`
+	TpTriggerBy  string `json:"tpTriggerBy,omitempty" validate:"omitempty,oneof=LastPrice IndexPrice MarkPrice"`
+	SlTriggerBy  string `json:"slTriggerBy,omitempty" validate:"omitempty,oneof=LastPrice IndexPrice MarkPrice"`
+	TriggerBy    string `json:"triggerBy,omitempty" validate:"omitempty,oneof=LastPrice IndexPrice MarkPrice"`
+	TpLimitPrice string `json:"tpLimitPrice,omitempty"`
+	SlLimitPrice string `json:"slLimitPrice,omitempty"`
+}
+
+type CancelOrderParam struct {
+	Category Category `json:"category" validate:"required,oneof=spot linear inverse option"`
+	CancelOrderRequest
+}
+
+// CancelOrderRequest is the order of CancelOrderParam and BatchCancelOrdersParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/cancel-order
+type CancelOrderRequest struct {
+	Symbol      string `json:"symbol" validate:"required"`
+	OrderId     string `json:"orderId,omitempty" validate:"required_without=OrderLinkId"`
+	OrderLinkId string `json:"orderLinkId,omitempty"`
+	OrderFilter string `json:"orderFilter,omitempty" validate:"omitempty,oneof=Order tpslOrder StopOrder"`
+}
+
+type OrderResp struct {
+	Http *utils.ApiResponse
+	Body *OrderAPIResp
+}
+
+type OrderAPIResp struct {
+	Response `json:",inline"`
+	Result   OrderResult `json:"result"`
+}
+
+type OrderResult struct {
+	OrderId     string `json:"orderId"`
+	OrderLinkId string `json:"orderLinkId"`
+}
+
+// CancelAllOrdersParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/cancel-all
+type CancelAllOrdersParam struct {
+	Category      Category `json:"category" validate:"required,oneof=spot linear inverse option"`
+	Symbol        string   `json:"symbol,omitempty"`
+	BaseCoin      string   `json:"baseCoin,omitempty"`
+	SettleCoin    string   `json:"settleCoin,omitempty"`
+	OrderFilter   string   `json:"orderFilter,omitempty" validate:"omitempty,oneof=Order tpslOrder StopOrder"`
+	StopOrderType string   `json:"stopOrderType,omitempty"`
+}
+
+type CancelAllOrdersResp struct {
+	Http *utils.ApiResponse
+	Body *CancelAllOrdersAPIResp
+}
+
+type CancelAllOrdersAPIResp struct {
+	Response `json:",inline"`
+	Result   struct {
+		List    []OrderResult `json:"list"`
+		Success string        `json:"success"`
+	} `json:"result"`
+}
+
+// BatchPlaceOrdersParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/batch-place
+type BatchPlaceOrdersParam struct {
+	Category Category            `json:"category" validate:"required,oneof=spot linear inverse option"`
+	Request  []PlaceOrderRequest `json:"request" validate:"required,min=1,max=20,dive"`
+}
+
+// BatchAmendOrdersParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/batch-amend
+type BatchAmendOrdersParam struct {
+	Category Category            `json:"category" validate:"required,oneof=spot linear inverse option"`
+	Request  []AmendOrderRequest `json:"request" validate:"required,min=1,max=20,dive"`
+}
+
+// BatchCancelOrdersParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/batch-cancel
+type BatchCancelOrdersParam struct {
+	Category Category             `json:"category" validate:"required,oneof=spot linear inverse option"`
+	Request  []CancelOrderRequest `json:"request" validate:"required,min=1,max=20,dive"`
+}
+
+type BatchOrdersResp struct {
+	Http *utils.ApiResponse
+	Body *BatchOrdersAPIResp
+}
+
+// BatchOrdersAPIResp, the result of each order is in RetExtInfo.List with the same index as Result.List
+type BatchOrdersAPIResp struct {
+	Response `json:",inline"`
+	Result   struct {
+		List []BatchOrderResult `json:"list"`
+	} `json:"result"`
+	RetExtInfo struct {
+		List []BatchOrderStatus `json:"list"`
+	} `json:"retExtInfo"`
+}
+
+type BatchOrderResult struct {
+	Category    string `json:"category"`
+	Symbol      string `json:"symbol"`
+	OrderId     string `json:"orderId"`
+	OrderLinkId string `json:"orderLinkId"`
+	CreateAt    string `json:"createAt"`
+}
+
+type BatchOrderStatus struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// GetOpenOrdersParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/open-order
+type GetOpenOrdersParam struct {
+	Category    Category `url:"category" validate:"required,oneof=spot linear inverse option"`
+	Symbol      string   `url:"symbol,omitempty"`
+	BaseCoin    string   `url:"baseCoin,omitempty"`
+	SettleCoin  string   `url:"settleCoin,omitempty"`
+	OrderId     string   `url:"orderId,omitempty"`
+	OrderLinkId string   `url:"orderLinkId,omitempty"`
+	OpenOnly    int      `url:"openOnly,omitempty" validate:"omitempty,oneof=0 1 2"`
+	OrderFilter string   `url:"orderFilter,omitempty"`
+	Limit       int      `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor      string   `url:"cursor,omitempty"`
+}
+
+// GetOrderHistoryParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/order-list
+type GetOrderHistoryParam struct {
+	Category    Category `url:"category" validate:"required,oneof=spot linear inverse option"`
+	Symbol      string   `url:"symbol,omitempty"`
+	BaseCoin    string   `url:"baseCoin,omitempty"`
+	SettleCoin  string   `url:"settleCoin,omitempty"`
+	OrderId     string   `url:"orderId,omitempty"`
+	OrderLinkId string   `url:"orderLinkId,omitempty"`
+	OrderFilter string   `url:"orderFilter,omitempty"`
+	OrderStatus string   `url:"orderStatus,omitempty"`
+	StartTime   int64    `url:"startTime,omitempty"`
+	EndTime     int64    `url:"endTime,omitempty"`
+	Limit       int      `url:"limit,omitempty" validate:"omitempty,max=50"`
+	Cursor      string   `url:"cursor,omitempty"`
+}
+
+type GetOrdersResp struct {
+	Http *utils.ApiResponse
+	Body *GetOrdersAPIResp
+}
+
+type GetOrdersAPIResp struct {
+	Response `json:",inline"`
+	Result   OrdersResult `json:"result"`
+}
+
+type OrdersResult struct {
+	Category       string  `json:"category"`
+	NextPageCursor string  `json:"nextPageCursor"`
+	List           []Order `json:"list"`
+}
+
+type Order struct {
+	OrderId            string `json:"orderId"`
+	OrderLinkId        string `json:"orderLinkId"`
+	BlockTradeId       string `json:"blockTradeId"`
+	Symbol             string `json:"symbol"`
+	Price              string `json:"price"`
+	Qty                string `json:"qty"`
+	Side               string `json:"side"`
+	IsLeverage         string `json:"isLeverage"`
+	PositionIdx        int    `json:"positionIdx"`
+	OrderStatus        string `json:"orderStatus"`
+	CreateType         string `json:"createType"`
+	CancelType         string `json:"cancelType"`
+	RejectReason       string `json:"rejectReason"`
+	AvgPrice           string `json:"avgPrice"`
+	LeavesQty          string `json:"leavesQty"`
+	LeavesValue        string `json:"leavesValue"`
+	CumExecQty         string `json:"cumExecQty"`
+	CumExecValue       string `json:"cumExecValue"`
+	CumExecFee         string `json:"cumExecFee"`
+	TimeInForce        string `json:"timeInForce"`
+	OrderType          string `json:"orderType"`
+	StopOrderType      string `json:"stopOrderType"`
+	OrderIv            string `json:"orderIv"`
+	MarketUnit         string `json:"marketUnit"`
+	TriggerPrice       string `json:"triggerPrice"`
+	TakeProfit         string `json:"takeProfit"`
+	StopLoss           string `json:"stopLoss"`
+	TpslMode           string `json:"tpslMode"`
+	OcoTriggerBy       string `json:"ocoTriggerBy"`
+	TpLimitPrice       string `json:"tpLimitPrice"`
+	SlLimitPrice       string `json:"slLimitPrice"`
+	TpTriggerBy        string `json:"tpTriggerBy"`
+	SlTriggerBy        string `json:"slTriggerBy"`
+	TriggerDirection   int    `json:"triggerDirection"`
+	TriggerBy          string `json:"triggerBy"`
+	LastPriceOnCreated string `json:"lastPriceOnCreated"`
+	ReduceOnly         bool   `json:"reduceOnly"`
+	CloseOnTrigger     bool   `json:"closeOnTrigger"`
+	PlaceType          string `json:"placeType"`
+	SmpType            string `json:"smpType"`
+	SmpGroup           int    `json:"smpGroup"`
+	SmpOrderId         string `json:"smpOrderId"`
+	CreatedTime        string `json:"createdTime"`
+	UpdatedTime        string `json:"updatedTime"`
+}
+
+// GetExecutionsParam
+// doc: https://bybit-exchange.github.io/docs/v5/order/execution
+type GetExecutionsParam struct {
+	Category    Category `url:"category" validate:"required,oneof=spot linear inverse option"`
+	Symbol      string   `url:"symbol,omitempty"`
+	OrderId     string   `url:"orderId,omitempty"`
+	OrderLinkId string   `url:"orderLinkId,omitempty"`
+	BaseCoin    string   `url:"baseCoin,omitempty"`
+	StartTime   int64    `url:"startTime,omitempty"`
+	EndTime     int64    `url:"endTime,omitempty"`
+	ExecType    string   `url:"execType,omitempty"`
+	Limit       int      `url:"limit,omitempty" validate:"omitempty,max=100"`
+	Cursor      string   `url:"cursor,omitempty"`
+}
+
+type GetExecutionsResp struct {
+	Http *utils.ApiResponse
+	Body *GetExecutionsAPIResp
+}
+
+type GetExecutionsAPIResp struct {
+	Response `json:",inline"`
+	Result   struct {
+		Category       string      `json:"category"`
+		NextPageCursor string      `json:"nextPageCursor"`
+		List           []Execution `json:"list"`
+	} `json:"result"`
+}
+
+type Execution struct {
+	Symbol          string `json:"symbol"`
+	OrderId         string `json:"orderId"`
+	OrderLinkId     string `json:"orderLinkId"`
+	Side            string `json:"side"`
+	OrderPrice      string `json:"orderPrice"`
+	OrderQty        string `json:"orderQty"`
+	LeavesQty       string `json:"leavesQty"`
+	CreateType      string `json:"createType"`
+	OrderType       string `json:"orderType"`
+	StopOrderType   string `json:"stopOrderType"`
+	ExecFee         string `json:"execFee"`
+	ExecId          string `json:"execId"`
+	ExecPrice       string `json:"execPrice"`
+	ExecQty         string `json:"execQty"`
+	ExecType        string `json:"execType"`
+	ExecValue       string `json:"execValue"`
+	ExecTime        string `json:"execTime"`
+	FeeCurrency     string `json:"feeCurrency"`
+	IsMaker         bool   `json:"isMaker"`
+	FeeRate         string `json:"feeRate"`
+	TradeIv         string `json:"tradeIv"`
+	MarkIv          string `json:"markIv"`
+	MarkPrice       string `json:"markPrice"`
+	IndexPrice      string `json:"indexPrice"`
+	UnderlyingPrice string `json:"underlyingPrice"`
+	BlockTradeId    string `json:"blockTradeId"`
+	ClosedSize      string `json:"closedSize"`
+	Seq             int64  `json:"seq"`
+}
+
+// SetDCPParam, all orders of Product are cancelled when the websocket disconnects longer than TimeWindow seconds
+// doc: https://bybit-exchange.github.io/docs/v5/order/dcp
+type SetDCPParam struct {
+	Product    string `json:"product,omitempty" validate:"omitempty,oneof=OPTIONS DERIVATIVES SPOT"`
+	TimeWindow int    `json:"timeWindow" validate:"required,min=3,max=300"`
+}
+
+type SetDCPResp struct {
+	Http *utils.ApiResponse
+	Body *SetDCPAPIResp
+}
+
+type SetDCPAPIResp struct {
+	Response `json:",inline"`
+}
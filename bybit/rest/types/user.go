@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "github.com/linstohu/nexapi/utils"
+
+// CreateSubMemberParam, MemberType is 1 for a normal sub-account and 6 for a custodial sub-account
+// doc: https://bybit-exchange.github.io/docs/v5/user/create-subuid
+type CreateSubMemberParam struct {
+	Username   string `json:"username" validate:"required,min=6,max=16"`
+	Password   string `json:"password,omitempty"`
+	MemberType int    `json:"memberType" validate:"required,oneof=1 6"`
+	// Switch 1 turns on the quick login
+	Switch int    `json:"switch,omitempty" validate:"omitempty,oneof=0 1"`
+	IsUta  bool   `json:"isUta,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+type SubMember struct {
+	Uid         string `json:"uid"`
+	Username    string `json:"username"`
+	MemberType  int    `json:"memberType"`
+	Status      int    `json:"status"`
+	AccountMode int    `json:"accountMode"`
+	Remark      string `json:"remark"`
+}
+
+type SubMembersResult struct {
+	SubMembers []SubMember `json:"subMembers"`
+}
+
+// FreezeSubMemberParam, Frozen 1 freezes and 0 unfreezes the sub-account
+// doc: https://bybit-exchange.github.io/docs/v5/user/froze-subuid
+type FreezeSubMemberParam struct {
+	Subuid int64 `json:"subuid" validate:"required"`
+	Frozen int   `json:"frozen" validate:"oneof=0 1"`
+}
+
+// SubAPIPermissions lists the permissions of each product, e.g. Spot: ["SpotTrade"]
+// doc: https://bybit-exchange.github.io/docs/v5/user/create-subuid-apikey
+type SubAPIPermissions struct {
+	ContractTrade []string `json:"ContractTrade,omitempty"`
+	Spot          []string `json:"Spot,omitempty"`
+	Wallet        []string `json:"Wallet,omitempty"`
+	Options       []string `json:"Options,omitempty"`
+	Derivatives   []string `json:"Derivatives,omitempty"`
+	Exchange      []string `json:"Exchange,omitempty"`
+	CopyTrading   []string `json:"CopyTrading,omitempty"`
+	BlockTrade    []string `json:"BlockTrade,omitempty"`
+	NFT           []string `json:"NFT,omitempty"`
+	Earn          []string `json:"Earn,omitempty"`
+}
+
+// CreateSubAPIKeyParam, Ips is a comma separated list of bound IPs
+type CreateSubAPIKeyParam struct {
+	Subuid      int64             `json:"subuid" validate:"required"`
+	Note        string            `json:"note,omitempty"`
+	ReadOnly    int               `json:"readOnly" validate:"oneof=0 1"`
+	Ips         string            `json:"ips,omitempty"`
+	Permissions SubAPIPermissions `json:"permissions"`
+}
+
+// ModifySubAPIKeyParam modifies the key of Apikey with a master account key,
+// or the key used to sign the request when Apikey is empty
+// doc: https://bybit-exchange.github.io/docs/v5/user/modify-sub-apikey
+type ModifySubAPIKeyParam struct {
+	Apikey      string             `json:"apikey,omitempty"`
+	ReadOnly    int                `json:"readOnly,omitempty" validate:"omitempty,oneof=0 1"`
+	Ips         string             `json:"ips,omitempty"`
+	Permissions *SubAPIPermissions `json:"permissions,omitempty"`
+}
+
+// DeleteSubAPIKeyParam deletes the key of Apikey with a master account key,
+// or the key used to sign the request when Apikey is empty
+// doc: https://bybit-exchange.github.io/docs/v5/user/rm-sub-apikey
+type DeleteSubAPIKeyParam struct {
+	Apikey string `json:"apikey,omitempty"`
+}
+
+type SubAPIKey struct {
+	Id          string            `json:"id"`
+	Note        string            `json:"note"`
+	ApiKey      string            `json:"apiKey"`
+	ReadOnly    int               `json:"readOnly"`
+	Secret      string            `json:"secret"`
+	Permissions SubAPIPermissions `json:"permissions"`
+	Ips         []string          `json:"ips"`
+}
+
+type CreateSubMemberResp struct {
+	Http *utils.ApiResponse
+	Body *CreateSubMemberAPIResp
+}
+
+type CreateSubMemberAPIResp struct {
+	Response `json:",inline"`
+	Result   SubMember `json:"result"`
+}
+
+type GetSubMembersResp struct {
+	Http *utils.ApiResponse
+	Body *GetSubMembersAPIResp
+}
+
+type GetSubMembersAPIResp struct {
+	Response `json:",inline"`
+	Result   SubMembersResult `json:"result"`
+}
+
+type SubAPIKeyResp struct {
+	Http *utils.ApiResponse
+	Body *SubAPIKeyAPIResp
+}
+
+type SubAPIKeyAPIResp struct {
+	Response `json:",inline"`
+	Result   SubAPIKey `json:"result"`
+}
@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linstohu/nexapi/bybit/rest/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+// CreateSubMember creates a sub-account, it needs a master account key
+// doc: https://bybit-exchange.github.io/docs/v5/user/create-subuid
+func (bb *BybitClient) CreateSubMember(ctx context.Context, param types.CreateSubMemberParam) (*types.CreateSubMemberResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/user/create-sub-member",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.CreateSubMemberAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.CreateSubMemberResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// GetSubMembers returns up to 10k sub-accounts
+// doc: https://bybit-exchange.github.io/docs/v5/user/subuid-list
+func (bb *BybitClient) GetSubMembers(ctx context.Context) (*types.GetSubMembersResp, error) {
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/user/query-sub-members",
+		Method:  http.MethodGet,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetSubMembersAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.GetSubMembersResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// FreezeSubMember
+// doc: https://bybit-exchange.github.io/docs/v5/user/froze-subuid
+func (bb *BybitClient) FreezeSubMember(ctx context.Context, param types.FreezeSubMemberParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/user/frozen-sub-member",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// CreateSubAPIKey
+// doc: https://bybit-exchange.github.io/docs/v5/user/create-subuid-apikey
+func (bb *BybitClient) CreateSubAPIKey(ctx context.Context, param types.CreateSubAPIKeyParam) (*types.SubAPIKeyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/user/create-sub-api",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.SubAPIKeyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.SubAPIKeyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// ModifySubAPIKey
+// doc: https://bybit-exchange.github.io/docs/v5/user/modify-sub-apikey
+func (bb *BybitClient) ModifySubAPIKey(ctx context.Context, param types.ModifySubAPIKeyParam) (*types.SubAPIKeyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/user/update-sub-api",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.SubAPIKeyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.SubAPIKeyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
+
+// DeleteSubAPIKey
+// doc: https://bybit-exchange.github.io/docs/v5/user/rm-sub-apikey
+func (bb *BybitClient) DeleteSubAPIKey(ctx context.Context, param types.DeleteSubAPIKeyParam) (*types.EmptyResp, error) {
+	err := bb.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: bb.cli.GetBaseURL(),
+		Path:    "/v5/user/delete-sub-api",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := bb.cli.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := bb.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.EmptyAPIResp
+
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	data := &types.EmptyResp{
+		Http: resp,
+		Body: &body,
+	}
+
+	return data, nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	// PublicWsURL is followed by the category, e.g. PublicWsURL + "/linear"
+	PublicWsURL     = "wss://stream.bybit.com/v5/public"
+	TestPublicWsURL = "wss://stream-testnet.bybit.com/v5/public"
+
+	PrivateWsURL     = "wss://stream.bybit.com/v5/private"
+	TestPrivateWsURL = "wss://stream-testnet.bybit.com/v5/private"
+
+	TradeWsURL     = "wss://stream.bybit.com/v5/trade"
+	TestTradeWsURL = "wss://stream-testnet.bybit.com/v5/trade"
+)
+
+const (
+	WsSubscribe   = "subscribe"
+	WsUnsubscribe = "unsubscribe"
+	WsAuth        = "auth"
+	WsPing        = "ping"
+	WsPong        = "pong"
+)
+
+// GenWsAuthArgs returns the args of the auth op, the signature expires after expire
+func GenWsAuthArgs(key, secret string, expire time.Duration) ([]any, error) {
+	if key == "" || secret == "" {
+		return nil, fmt.Errorf("key and secret needed when auth websocket")
+	}
+
+	expires := time.Now().Add(expire).UnixMilli()
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte("GET/realtime" + strconv.FormatInt(expires, 10)))
+	signature := hex.EncodeToString(h.Sum(nil))
+
+	return []any{key, expires, signature}, nil
+}
@@ -16,3 +16,496 @@
  */
 
 package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	bybitutils "github.com/linstohu/nexapi/bybit/utils"
+	"github.com/linstohu/nexapi/bybit/websocket/types"
+	"github.com/linstohu/nexapi/utils"
+	cmap "github.com/orcaman/concurrent-map/v2"
+)
+
+// client keeps the connection shared by the public and private clients, it authenticates
+// on every connect when key is set
+type client struct {
+	baseURL     string
+	key, secret string
+	// debug mode
+	debug bool
+	// logger
+	logger *slog.Logger
+
+	stopCtx context.Context
+	cancel  context.CancelFunc
+
+	conn        *websocket.Conn
+	mu          sync.RWMutex
+	isConnected bool
+
+	autoReconnect bool
+	// authResult belongs to the current connection, guarded by mu
+	authResult chan error
+
+	sending       sync.Mutex
+	subscriptions cmap.ConcurrentMap[string, struct{}]
+
+	// pending holds op requests waiting for the response with the same req_id
+	reqID   atomic.Uint64
+	pending cmap.ConcurrentMap[string, chan *types.Message]
+
+	// handle processes data pushes, it is called by the read goroutine only
+	handle func(*types.Message) error
+	// route replaces the parsing of types.Message when set, it is used by the trade stream
+	route func(buf []byte)
+	// onClose is called when the connection drops, the trade stream fails its waiting requests with it
+	onClose func()
+
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
+}
+
+func newClient(baseURL, key, secret string, debug, autoReconnect bool, logger *slog.Logger, dispatcher *utils.DispatcherCfg) *client {
+	cli := &client{
+		baseURL: baseURL,
+		key:     key,
+		secret:  secret,
+		debug:   debug,
+		logger:  logger,
+
+		autoReconnect: autoReconnect,
+
+		subscriptions: cmap.New[struct{}](),
+		pending:       cmap.New[chan *types.Message](),
+		emitter:       utils.NewEmitter(),
+	}
+
+	if cli.logger == nil {
+		cli.logger = slog.Default()
+	}
+
+	if dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, dispatcher)
+	}
+
+	return cli
+}
+
+func (c *client) Open() error {
+	if c.stopCtx != nil {
+		return fmt.Errorf("%s: ws is already open", logPrefix)
+	}
+
+	c.stopCtx, c.cancel = context.WithCancel(context.Background())
+
+	err := c.start()
+	if err != nil {
+		// do not keep reconnecting when the first connection fails
+		c.cancel()
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) Close() error {
+	if c.stopCtx == nil {
+		return fmt.Errorf("%s: ws is not open", logPrefix)
+	}
+
+	c.cancel()
+
+	if c.dispatcher != nil {
+		c.dispatcher.Close()
+	}
+
+	return nil
+}
+
+func (c *client) start() error {
+	c.setIsConnected(false)
+
+	// per connection state is handed to the goroutines, so a reconnect does not race with them
+	var (
+		conn        *websocket.Conn
+		heartCancel = make(chan struct{})
+		disconnect  = make(chan struct{})
+		authResult  = make(chan error, 1)
+	)
+
+	for i := 0; i < MaxTryTimes; i++ {
+		cn, _, err := c.connect()
+		if err != nil {
+			c.logger.Info(fmt.Sprintf("%s: connect error, times(%v), error: %s", logPrefix, i, err.Error()))
+			tm := (i + 1) * 5
+			time.Sleep(time.Duration(tm) * time.Second)
+			continue
+		}
+		conn = cn
+		break
+	}
+	if conn == nil {
+		return errors.New("connect failed")
+	}
+
+	c.logger.Info(fmt.Sprintf("%s: connect success, base_url: %s", logPrefix, c.baseURL))
+
+	c.sending.Lock()
+	c.conn = conn
+	c.sending.Unlock()
+
+	c.mu.Lock()
+	c.authResult = authResult
+	c.isConnected = true
+	c.mu.Unlock()
+
+	// op responses are read by readMessages
+	go c.readMessages(conn, disconnect)
+
+	// watch for disconnect before auth, a failed auth closes the connection and is retried by reconnect
+	if c.autoReconnect {
+		go c.reconnect(disconnect, heartCancel)
+	}
+
+	if c.key != "" {
+		if err := c.auth(authResult); err != nil {
+			c.logger.Error(fmt.Sprintf("%s: auth error, %s", logPrefix, err.Error()))
+			// readMessages sees the closed connection and calls close
+			conn.Close()
+			return err
+		}
+	}
+
+	if err := c.resubscribe(); err != nil {
+		c.logger.Error(fmt.Sprintf("%s: resubscribe error, %s", logPrefix, err.Error()))
+	}
+
+	go c.heartbeat(heartCancel)
+
+	return nil
+}
+
+func (c *client) connect() (*websocket.Conn, *http.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, c.baseURL, nil)
+	if err == nil {
+		conn.SetReadLimit(32768 * 64)
+	}
+
+	return conn, resp, err
+}
+
+func (c *client) reconnect(disconnect, heartCancel chan struct{}) {
+	<-disconnect
+
+	c.setIsConnected(false)
+
+	close(heartCancel)
+
+	time.Sleep(1 * time.Second)
+
+	select {
+	case <-c.stopCtx.Done():
+		c.logger.Info(fmt.Sprintf("%s: reconnection exits", logPrefix))
+		return
+	default:
+		c.logger.Info(fmt.Sprintf("%s: try to reconnect...", logPrefix))
+		c.start()
+	}
+}
+
+// close closes the websocket connection
+func (c *client) close(conn *websocket.Conn, disconnect chan struct{}) error {
+	c.setIsConnected(false)
+
+	if c.onClose != nil {
+		c.onClose()
+	}
+
+	close(disconnect)
+
+	err := conn.Close()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setIsConnected sets state for isConnected
+func (c *client) setIsConnected(state bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.isConnected = state
+}
+
+// IsConnected returns the WebSocket connection state
+func (c *client) IsConnected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.isConnected
+}
+
+// auth signs "GET/realtime" with an expiry, then waits for the response
+func (c *client) auth(authResult chan error) error {
+	args, err := bybitutils.GenWsAuthArgs(c.key, c.secret, AuthExpire*time.Second)
+	if err != nil {
+		return err
+	}
+
+	err = c.send(&types.Request{
+		ReqId: c.nextReqID(),
+		Op:    bybitutils.WsAuth,
+		Args:  args,
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-authResult:
+		return err
+	case <-time.After(AuthTimeout * time.Second):
+		return errors.New("auth timeout")
+	}
+}
+
+func (c *client) setAuthResult(err error) {
+	c.mu.RLock()
+	authResult := c.authResult
+	c.mu.RUnlock()
+
+	select {
+	case authResult <- err:
+	default:
+	}
+}
+
+// heartbeat sends a ping op every HeartbeatInterval seconds to keep alive
+func (c *client) heartbeat(heartCancel chan struct{}) {
+	t := time.NewTicker(HeartbeatInterval * time.Second)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			c.send(&types.Request{
+				ReqId: c.nextReqID(),
+				Op:    bybitutils.WsPing,
+			})
+		case <-heartCancel:
+			return
+		}
+	}
+}
+
+func (c *client) readMessages(conn *websocket.Conn, disconnect chan struct{}) {
+	for {
+		select {
+		case <-c.stopCtx.Done():
+			c.logger.Info(fmt.Sprintf("%s: ready to close...", logPrefix))
+
+			if err := c.close(conn, disconnect); err != nil {
+				c.logger.Error(fmt.Sprintf("%s: connection closed error, %s", logPrefix, err.Error()))
+				return
+			}
+
+			c.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
+			return
+		default:
+			_, buf, err := conn.ReadMessage()
+			if err != nil {
+				c.logger.Info(fmt.Sprintf("%s: read message error, %s", logPrefix, err))
+				c.logger.Info(fmt.Sprintf("%s: ready to close...", logPrefix))
+
+				if err := c.close(conn, disconnect); err != nil {
+					c.logger.Error(fmt.Sprintf("%s: connection closed error, %s", logPrefix, err.Error()))
+					return
+				}
+
+				c.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
+				return
+			}
+
+			if c.route != nil {
+				c.route(buf)
+				continue
+			}
+
+			var msg types.Message
+			if err := json.Unmarshal(buf, &msg); err != nil {
+				c.logger.Info(fmt.Sprintf("%s: read object error, %s", logPrefix, err))
+				continue
+			}
+
+			switch {
+			case msg.Op == bybitutils.WsAuth:
+				if msg.Success {
+					c.setAuthResult(nil)
+				} else {
+					c.setAuthResult(fmt.Errorf("auth failed, %s", msg.RetMsg))
+				}
+			// public streams answer with op ping, the private stream with op pong
+			case msg.Op == bybitutils.WsPing || msg.Op == bybitutils.WsPong:
+				continue
+			case msg.Op != "":
+				c.deliver(&msg)
+			case msg.Topic != "":
+				err := c.handle(&msg)
+				if err != nil {
+					c.logger.Info(fmt.Sprintf("%s: handle message error: %s", logPrefix, err.Error()))
+				}
+			}
+		}
+	}
+}
+
+func (c *client) resubscribe() error {
+	topics := c.subscriptions.Keys()
+
+	if len(topics) == 0 {
+		return nil
+	}
+
+	return c.request(bybitutils.WsSubscribe, topics)
+}
+
+func (c *client) subscribe(topics []string) error {
+	args := make([]string, 0, len(topics))
+
+	for _, topic := range topics {
+		if c.subscriptions.Has(topic) {
+			continue
+		}
+
+		args = append(args, topic)
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	err := c.request(bybitutils.WsSubscribe, args)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range args {
+		c.subscriptions.Set(v, struct{}{})
+	}
+
+	return nil
+}
+
+func (c *client) unsubscribe(topics []string) error {
+	err := c.request(bybitutils.WsUnsubscribe, topics)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range topics {
+		c.subscriptions.Remove(v)
+	}
+
+	return nil
+}
+
+// request sends topics in chunks of MaxArgs and waits for the response of each chunk by req_id
+func (c *client) request(op string, topics []string) error {
+	for i := 0; i < len(topics); i += MaxArgs {
+		chunk := topics[i:min(i+MaxArgs, len(topics))]
+
+		args := make([]any, 0, len(chunk))
+		for _, v := range chunk {
+			args = append(args, v)
+		}
+
+		id := c.nextReqID()
+
+		ch := make(chan *types.Message, 1)
+		c.pending.Set(id, ch)
+
+		err := c.send(&types.Request{
+			ReqId: id,
+			Op:    op,
+			Args:  args,
+		})
+		if err != nil {
+			c.pending.Remove(id)
+			return err
+		}
+
+		select {
+		case msg := <-ch:
+			c.pending.Remove(id)
+			if !msg.Success {
+				return fmt.Errorf("%s: %s failed, req_id: %s, %s", logPrefix, op, id, msg.RetMsg)
+			}
+		case <-time.After(RequestTimeout * time.Second):
+			c.pending.Remove(id)
+			return fmt.Errorf("%s: wait for response, op: %s, req_id: %s, timeout", logPrefix, op, id)
+		}
+	}
+
+	return nil
+}
+
+func (c *client) deliver(msg *types.Message) {
+	ch, ok := c.pending.Get(msg.ReqId)
+	if !ok {
+		if !msg.Success {
+			c.logger.Error(fmt.Sprintf("%s: op: %s, req_id: %s, ret_msg: %s", logPrefix, msg.Op, msg.ReqId, msg.RetMsg))
+		} else if c.debug {
+			c.logger.Info(fmt.Sprintf("%s: no pending request, op: %s, req_id: %s", logPrefix, msg.Op, msg.ReqId))
+		}
+		return
+	}
+
+	select {
+	case ch <- msg:
+	default:
+	}
+}
+
+func (c *client) nextReqID() string {
+	return strconv.FormatUint(c.reqID.Add(1), 10)
+}
+
+func (c *client) send(req *types.Request) error {
+	c.sending.Lock()
+	defer c.sending.Unlock()
+
+	if !c.IsConnected() {
+		return errors.New("connection is closed")
+	}
+
+	return c.conn.WriteJSON(req)
+}
+
+// emitEach decodes data as a list and calls listeners of topic with every element
+func emitEach[T any](c *client, topic string, data json.RawMessage) error {
+	var list []*T
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+
+	for _, v := range list {
+		c.GetListeners(topic, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,408 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	resttypes "github.com/linstohu/nexapi/bybit/rest/types"
+	bybitutils "github.com/linstohu/nexapi/bybit/utils"
+	"github.com/linstohu/nexapi/bybit/websocket/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNewPublicClient(t *testing.T, category string) *PublicClient {
+	cli, err := NewPublicClient(&PublicClientCfg{
+		Debug:         true,
+		BaseURL:       bybitutils.PublicWsURL,
+		Category:      category,
+		AutoReconnect: true,
+	})
+
+	if err != nil {
+		t.Fatalf("Could not create websocket client, %s", err)
+	}
+
+	err = cli.Open()
+	if err != nil {
+		t.Fatalf("Could not open websocket client, %s", err)
+	}
+
+	return cli
+}
+
+func testNewPrivateClient(t *testing.T) *PrivateClient {
+	cli, err := NewPrivateClient(&PrivateClientCfg{
+		Debug:         true,
+		BaseURL:       bybitutils.TestPrivateWsURL,
+		AutoReconnect: true,
+		Key:           os.Getenv("BYBIT_KEY"),
+		Secret:        os.Getenv("BYBIT_SECRET"),
+	})
+
+	if err != nil {
+		t.Fatalf("Could not create websocket client, %s", err)
+	}
+
+	err = cli.Open()
+	if err != nil {
+		t.Fatalf("Could not open websocket client, %s", err)
+	}
+
+	return cli
+}
+
+func TestSubscribeOrderBook(t *testing.T) {
+	cli := testNewPublicClient(t, "linear")
+	defer cli.Close()
+
+	topic, err := cli.GetOrderBookTopic(&OrderBookTopicParam{
+		Depth:  50,
+		Symbol: "BTCUSDT",
+	})
+	assert.Nil(t, err)
+
+	cli.OnOrderBook(topic, func(e *types.OrderBook) {
+		fmt.Printf("Topic: %s, Bids: %v, Asks: %v, UpdateId: %v\n", topic, len(e.Bids), len(e.Asks), e.UpdateId)
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+
+	err = cli.UnSubscribe([]string{topic})
+	assert.Nil(t, err)
+}
+
+func TestSubscribeFuturesTicker(t *testing.T) {
+	cli := testNewPublicClient(t, "linear")
+	defer cli.Close()
+
+	topic, err := cli.GetTickerTopic("BTCUSDT")
+	assert.Nil(t, err)
+
+	cli.OnFuturesTicker(topic, func(e *types.FuturesTicker) {
+		fmt.Printf("Topic: %s, LastPrice: %v, MarkPrice: %v\n", topic, e.LastPrice, e.MarkPrice)
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+}
+
+func TestSubscribeKline(t *testing.T) {
+	cli := testNewPublicClient(t, "spot")
+	defer cli.Close()
+
+	topic, err := cli.GetKlineTopic(&KlineTopicParam{
+		Interval: "1",
+		Symbol:   "BTCUSDT",
+	})
+	assert.Nil(t, err)
+
+	cli.OnKline(topic, func(e *types.Kline) {
+		fmt.Printf("Topic: %s, Open: %v, Close: %v, Confirm: %v\n", topic, e.Open, e.Close, e.Confirm)
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+}
+
+func TestSubscribeWallet(t *testing.T) {
+	cli := testNewPrivateClient(t)
+	defer cli.Close()
+
+	topic, err := cli.GetWalletTopic()
+	assert.Nil(t, err)
+
+	cli.OnWallet(topic, func(e *types.Wallet) {
+		fmt.Printf("Topic: %s, AccountType: %v, TotalEquity: %v\n", topic, e.AccountType, e.TotalEquity)
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+}
+
+func TestSubscribeOrder(t *testing.T) {
+	cli := testNewPrivateClient(t)
+	defer cli.Close()
+
+	topic, err := cli.GetOrderTopic(&CategoryTopicParam{})
+	assert.Nil(t, err)
+
+	cli.OnOrder(topic, func(e *types.Order) {
+		fmt.Printf("Topic: %s, Symbol: %v, OrderId: %v, OrderStatus: %v\n", topic, e.Symbol, e.OrderId, e.OrderStatus)
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+}
+
+func testNewTradeClient(t *testing.T) *TradeClient {
+	cli, err := NewTradeClient(&TradeClientCfg{
+		Debug:         true,
+		BaseURL:       bybitutils.TestTradeWsURL,
+		AutoReconnect: true,
+		Key:           os.Getenv("BYBIT_KEY"),
+		Secret:        os.Getenv("BYBIT_SECRET"),
+	})
+
+	if err != nil {
+		t.Fatalf("Could not create websocket client, %s", err)
+	}
+
+	err = cli.Open()
+	if err != nil {
+		t.Fatalf("Could not open websocket client, %s", err)
+	}
+
+	return cli
+}
+
+func TestTradePlaceAndCancelOrder(t *testing.T) {
+	cli := testNewTradeClient(t)
+	defer cli.Close()
+
+	resp, err := cli.PlaceOrder(context.TODO(), resttypes.PlaceOrderParam{
+		Category: resttypes.Spot,
+		PlaceOrderRequest: resttypes.PlaceOrderRequest{
+			Symbol:      "BTCUSDT",
+			Side:        "Buy",
+			OrderType:   "Limit",
+			Qty:         "0.001",
+			Price:       "10000",
+			TimeInForce: "PostOnly",
+		},
+	})
+	assert.Nil(t, err)
+
+	fmt.Printf("OrderId: %s, Limit: %+v\n", resp.Result.OrderId, resp.Limit)
+
+	_, err = cli.CancelOrder(context.TODO(), resttypes.CancelOrderParam{
+		Category: resttypes.Spot,
+		CancelOrderRequest: resttypes.CancelOrderRequest{
+			Symbol:  "BTCUSDT",
+			OrderId: resp.Result.OrderId,
+		},
+	})
+	assert.Nil(t, err)
+}
+
+func TestReconnectAfterAuthFailure(t *testing.T) {
+	var conns atomic.Int32
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		n := conns.Add(1)
+
+		for {
+			var req types.Request
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+
+			if req.Op != bybitutils.WsAuth {
+				continue
+			}
+
+			switch n {
+			case 1:
+				// auth, then drop the connection
+				conn.WriteJSON(types.Message{Op: req.Op, ReqId: req.ReqId, Success: true})
+				return
+			case 2:
+				conn.WriteJSON(types.Message{Op: req.Op, ReqId: req.ReqId, RetMsg: "Request expired"})
+			default:
+				conn.WriteJSON(types.Message{Op: req.Op, ReqId: req.ReqId, Success: true})
+			}
+		}
+	}))
+	defer srv.Close()
+
+	cli, err := NewPrivateClient(&PrivateClientCfg{
+		BaseURL:       "ws" + strings.TrimPrefix(srv.URL, "http"),
+		AutoReconnect: true,
+		Key:           "key",
+		Secret:        "secret",
+	})
+	assert.Nil(t, err)
+
+	err = cli.Open()
+	assert.Nil(t, err)
+	defer cli.Close()
+
+	assert.Eventually(t, func() bool {
+		return conns.Load() == 3 && cli.IsConnected()
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestTradeRequestFailsOnDisconnect(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var req types.TradeRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+
+			if req.Op == bybitutils.WsAuth {
+				conn.WriteJSON(types.TradeMessage{Op: req.Op})
+				continue
+			}
+
+			// drop the connection without answering the order
+			return
+		}
+	}))
+	defer srv.Close()
+
+	cli, err := NewTradeClient(&TradeClientCfg{
+		BaseURL:       "ws" + strings.TrimPrefix(srv.URL, "http"),
+		AutoReconnect: true,
+		Key:           "key",
+		Secret:        "secret",
+	})
+	assert.Nil(t, err)
+
+	err = cli.Open()
+	assert.Nil(t, err)
+	defer cli.Close()
+
+	start := time.Now()
+	_, err = cli.PlaceOrder(context.TODO(), resttypes.PlaceOrderParam{
+		Category: resttypes.Spot,
+		PlaceOrderRequest: resttypes.PlaceOrderRequest{
+			Symbol:    "BTCUSDT",
+			Side:      "Buy",
+			OrderType: "Limit",
+			Qty:       "0.001",
+			Price:     "10000",
+		},
+	})
+	assert.ErrorIs(t, err, ErrDisconnected)
+	assert.Less(t, time.Since(start), RequestTimeout*time.Second)
+	assert.Empty(t, cli.orders.Keys())
+}
+
+func TestOrderBookMerge(t *testing.T) {
+	cli, err := NewPublicClient(&PublicClientCfg{
+		BaseURL:       bybitutils.PublicWsURL,
+		Category:      "linear",
+		AutoReconnect: true,
+	})
+	assert.Nil(t, err)
+
+	topic := "orderbook.50.BTCUSDT"
+
+	var got *types.OrderBook
+	cli.OnOrderBook(topic, func(e *types.OrderBook) {
+		got = e
+	})
+
+	push := func(typ, data string) error {
+		return cli.handleMessage(&types.Message{Topic: topic, Type: typ, Ts: 1, Data: []byte(data)})
+	}
+
+	level := func(price, size string) types.PriceLevel {
+		return types.PriceLevel{Price: price, Size: size}
+	}
+
+	tests := []struct {
+		name string
+		typ  string
+		data string
+		bids []types.PriceLevel
+		asks []types.PriceLevel
+		err  bool
+	}{
+		{
+			name: "delta before snapshot",
+			typ:  types.Delta,
+			data: `{"s":"BTCUSDT","b":[["100","1"]],"a":[],"u":2,"seq":2}`,
+			err:  true,
+		},
+		{
+			name: "snapshot",
+			typ:  types.Snapshot,
+			data: `{"s":"BTCUSDT","b":[["99","2"],["100","1"],["98.5","3"]],"a":[["102","1"],["101","2"]],"u":10,"seq":10}`,
+			bids: []types.PriceLevel{level("100", "1"), level("99", "2"), level("98.5", "3")},
+			asks: []types.PriceLevel{level("101", "2"), level("102", "1")},
+		},
+		{
+			name: "delta updates, inserts and deletes",
+			typ:  types.Delta,
+			data: `{"s":"BTCUSDT","b":[["100","0"],["99","5"],["99.5","1"]],"a":[["101","0"],["103","4"]],"u":11,"seq":11}`,
+			bids: []types.PriceLevel{level("99.5", "1"), level("99", "5"), level("98.5", "3")},
+			asks: []types.PriceLevel{level("102", "1"), level("103", "4")},
+		},
+		{
+			name: "u=1 replaces the book",
+			typ:  types.Delta,
+			data: `{"s":"BTCUSDT","b":[["90","1"]],"a":[["91","1"]],"u":1,"seq":12}`,
+			bids: []types.PriceLevel{level("90", "1")},
+			asks: []types.PriceLevel{level("91", "1")},
+		},
+	}
+
+	for _, tt := range tests {
+		got = nil
+
+		err := push(tt.typ, tt.data)
+		if tt.err {
+			assert.NotNil(t, err, tt.name)
+			assert.Nil(t, got, tt.name)
+			continue
+		}
+
+		assert.Nil(t, err, tt.name)
+		if assert.NotNil(t, got, tt.name) {
+			assert.Equal(t, tt.bids, got.Bids, tt.name)
+			assert.Equal(t, tt.asks, got.Asks, tt.name)
+			assert.Equal(t, "BTCUSDT", got.Symbol, tt.name)
+		}
+	}
+}
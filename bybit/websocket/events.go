@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"github.com/linstohu/nexapi/bybit/websocket/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type Listener = utils.Listener
+
+// AddListener registers listener for event, the returned func removes it.
+func (c *client) AddListener(event string, listener Listener) func() {
+	return c.emitter.On(event, listener)
+}
+
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (c *client) RemoveListener(event string, listener Listener) {
+	c.emitter.Off(event, listener)
+}
+
+func (c *client) GetListeners(event string, argument any) {
+	if c.dispatcher != nil {
+		c.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	c.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (c *client) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if c.dispatcher == nil {
+		return nil
+	}
+
+	return c.dispatcher.Metrics()
+}
+
+func (p *PublicClient) OnOrderBook(topic string, fn func(*types.OrderBook)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicClient) OnTrade(topic string, fn func(*types.Trade)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicClient) OnSpotTicker(topic string, fn func(*types.SpotTicker)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicClient) OnFuturesTicker(topic string, fn func(*types.FuturesTicker)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicClient) OnOptionTicker(topic string, fn func(*types.OptionTicker)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicClient) OnKline(topic string, fn func(*types.Kline)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicClient) OnLiquidation(topic string, fn func(*types.Liquidation)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicClient) OnLTKline(topic string, fn func(*types.LTKline)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateClient) OnPosition(topic string, fn func(*types.Position)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateClient) OnExecution(topic string, fn func(*types.Execution)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateClient) OnOrder(topic string, fn func(*types.Order)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateClient) OnWallet(topic string, fn func(*types.Wallet)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateClient) OnGreeks(topic string, fn func(*types.Greeks)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateClient) OnDCP(topic string, fn func(*types.DCP)) func() {
+	return utils.On(p, topic, fn)
+}
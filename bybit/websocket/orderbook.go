@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/linstohu/nexapi/bybit/websocket/types"
+)
+
+// orderBook is the local order book of one topic, keyed by price
+type orderBook struct {
+	bids, asks map[string]string
+}
+
+func newOrderBook(data *types.OrderBookData) *orderBook {
+	b := &orderBook{
+		bids: make(map[string]string, len(data.Bids)),
+		asks: make(map[string]string, len(data.Asks)),
+	}
+
+	b.update(data)
+
+	return b
+}
+
+// update applies a delta, a size of 0 deletes the price level
+func (b *orderBook) update(data *types.OrderBookData) {
+	apply(b.bids, data.Bids)
+	apply(b.asks, data.Asks)
+}
+
+func (b *orderBook) levels(data *types.OrderBookData, ts, cts int64) *types.OrderBook {
+	return &types.OrderBook{
+		Symbol:   data.Symbol,
+		Bids:     sortLevels(b.bids, true),
+		Asks:     sortLevels(b.asks, false),
+		UpdateId: data.UpdateId,
+		Seq:      data.Seq,
+		Ts:       ts,
+		Cts:      cts,
+	}
+}
+
+func apply(side map[string]string, levels [][2]string) {
+	for _, v := range levels {
+		size, err := strconv.ParseFloat(v[1], 64)
+		if err == nil && size == 0 {
+			delete(side, v[0])
+			continue
+		}
+		side[v[0]] = v[1]
+	}
+}
+
+func sortLevels(side map[string]string, desc bool) []types.PriceLevel {
+	type level struct {
+		price float64
+		types.PriceLevel
+	}
+
+	list := make([]level, 0, len(side))
+	for price, size := range side {
+		p, _ := strconv.ParseFloat(price, 64)
+		list = append(list, level{p, types.PriceLevel{Price: price, Size: size}})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if desc {
+			return list[i].price > list[j].price
+		}
+		return list[i].price < list[j].price
+	})
+
+	ret := make([]types.PriceLevel, 0, len(list))
+	for _, v := range list {
+		ret = append(ret, v.PriceLevel)
+	}
+
+	return ret
+}
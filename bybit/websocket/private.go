@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/go-playground/validator"
+	"github.com/linstohu/nexapi/bybit/websocket/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type PrivateClient struct {
+	*client
+}
+
+type PrivateClientCfg struct {
+	Debug bool
+	// bybitutils.PrivateWsURL or bybitutils.TestPrivateWsURL
+	BaseURL       string `validate:"required"`
+	AutoReconnect bool   `validate:"required"`
+
+	Key    string `validate:"required"`
+	Secret string `validate:"required"`
+
+	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
+}
+
+func NewPrivateClient(cfg *PrivateClientCfg) (*PrivateClient, error) {
+	if err := validator.New().Struct(cfg); err != nil {
+		return nil, err
+	}
+
+	cli := &PrivateClient{
+		client: newClient(cfg.BaseURL, cfg.Key, cfg.Secret, cfg.Debug, cfg.AutoReconnect, cfg.Logger, cfg.Dispatcher),
+	}
+
+	cli.handle = cli.handleMessage
+
+	return cli, nil
+}
+
+func (p *PrivateClient) Subscribe(topics []string) error {
+	return p.subscribe(topics)
+}
+
+func (p *PrivateClient) UnSubscribe(topics []string) error {
+	return p.unsubscribe(topics)
+}
+
+func (p *PrivateClient) handleMessage(msg *types.Message) error {
+	topic := msg.Topic
+
+	if p.debug {
+		p.logger.Info(fmt.Sprintf("%s: subscribed message, topic: %s", logPrefix, topic))
+	}
+
+	channel, _, _ := strings.Cut(topic, ".")
+
+	switch channel {
+	case "position":
+		return emitEach[types.Position](p.client, topic, msg.Data)
+	case "execution":
+		return emitEach[types.Execution](p.client, topic, msg.Data)
+	case "order":
+		return emitEach[types.Order](p.client, topic, msg.Data)
+	case "wallet":
+		return emitEach[types.Wallet](p.client, topic, msg.Data)
+	case "greeks":
+		return emitEach[types.Greeks](p.client, topic, msg.Data)
+	case "dcp":
+		return emitEach[types.DCP](p.client, topic, msg.Data)
+	default:
+		return fmt.Errorf("unknown message, topic: %s", topic)
+	}
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/go-playground/validator"
+	"github.com/linstohu/nexapi/bybit/websocket/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type PublicClient struct {
+	*client
+	category string
+
+	// books and tickers are only touched by the read goroutine
+	books   map[string]*orderBook
+	tickers map[string]*types.FuturesTicker
+}
+
+type PublicClientCfg struct {
+	Debug bool
+	// bybitutils.PublicWsURL or bybitutils.TestPublicWsURL, the category is appended
+	BaseURL       string `validate:"required"`
+	Category      string `validate:"required,oneof=spot linear inverse option"`
+	AutoReconnect bool   `validate:"required"`
+
+	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
+}
+
+func NewPublicClient(cfg *PublicClientCfg) (*PublicClient, error) {
+	if err := validator.New().Struct(cfg); err != nil {
+		return nil, err
+	}
+
+	cli := &PublicClient{
+		client:   newClient(strings.TrimSuffix(cfg.BaseURL, "/")+"/"+cfg.Category, "", "", cfg.Debug, cfg.AutoReconnect, cfg.Logger, cfg.Dispatcher),
+		category: cfg.Category,
+		books:    make(map[string]*orderBook),
+		tickers:  make(map[string]*types.FuturesTicker),
+	}
+
+	cli.handle = cli.handleMessage
+
+	return cli, nil
+}
+
+func (p *PublicClient) Subscribe(topics []string) error {
+	return p.subscribe(topics)
+}
+
+func (p *PublicClient) UnSubscribe(topics []string) error {
+	return p.unsubscribe(topics)
+}
+
+func (p *PublicClient) handleMessage(msg *types.Message) error {
+	topic := msg.Topic
+
+	if p.debug {
+		p.logger.Info(fmt.Sprintf("%s: subscribed message, topic: %s, type: %s", logPrefix, topic, msg.Type))
+	}
+
+	channel, _, _ := strings.Cut(topic, ".")
+
+	switch channel {
+	case "orderbook":
+		var data types.OrderBookData
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+
+		book, ok := p.books[topic]
+		// a snapshot with u=1 is pushed again after the service restarts
+		switch {
+		case msg.Type == types.Snapshot || data.UpdateId == 1:
+			book = newOrderBook(&data)
+			p.books[topic] = book
+		case !ok:
+			return fmt.Errorf("delta before snapshot, topic: %s", topic)
+		default:
+			book.update(&data)
+		}
+
+		p.GetListeners(topic, book.levels(&data, msg.Ts, msg.Cts))
+	case "publicTrade":
+		return emitEach[types.Trade](p.client, topic, msg.Data)
+	case "tickers":
+		switch p.category {
+		case "spot":
+			var data types.SpotTicker
+			err := json.Unmarshal(msg.Data, &data)
+			if err != nil {
+				return err
+			}
+			p.GetListeners(topic, &data)
+		case "option":
+			var data types.OptionTicker
+			err := json.Unmarshal(msg.Data, &data)
+			if err != nil {
+				return err
+			}
+			p.GetListeners(topic, &data)
+		default:
+			// deltas only carry the changed fields, so decode them on top of the last ticker
+			ticker, ok := p.tickers[topic]
+			if !ok || msg.Type == types.Snapshot {
+				ticker = new(types.FuturesTicker)
+				p.tickers[topic] = ticker
+			}
+			err := json.Unmarshal(msg.Data, ticker)
+			if err != nil {
+				return err
+			}
+			data := *ticker
+			p.GetListeners(topic, &data)
+		}
+	case "kline":
+		return emitEach[types.Kline](p.client, topic, msg.Data)
+	case "liquidation":
+		var data types.Liquidation
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		p.GetListeners(topic, &data)
+	case "kline_lt":
+		return emitEach[types.LTKline](p.client, topic, msg.Data)
+	default:
+		return fmt.Errorf("unknown message, topic: %s", topic)
+	}
+
+	return nil
+}
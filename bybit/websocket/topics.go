@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator"
+)
+
+type OrderBookTopicParam struct {
+	// spot: 1, 50, 200; linear and inverse: 1, 50, 200, 500; option: 25, 100
+	Depth  int    `validate:"required"`
+	Symbol string `validate:"required"`
+}
+
+var orderBookDepths = map[string][]int{
+	"spot":    {1, 50, 200},
+	"linear":  {1, 50, 200, 500},
+	"inverse": {1, 50, 200, 500},
+	"option":  {25, 100},
+}
+
+func (p *PublicClient) GetOrderBookTopic(params *OrderBookTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range orderBookDepths[p.category] {
+		if v == params.Depth {
+			return fmt.Sprintf("orderbook.%d.%s", params.Depth, params.Symbol), nil
+		}
+	}
+
+	return "", fmt.Errorf("depth %d is not supported by %s", params.Depth, p.category)
+}
+
+// GetTradeTopic takes the base coin instead of the symbol for option, e.g. BTC
+func (p *PublicClient) GetTradeTopic(symbol string) (string, error) {
+	if symbol == "" {
+		return "", fmt.Errorf("the symbol field must be provided")
+	}
+
+	return "publicTrade." + symbol, nil
+}
+
+// GetTickerTopic pushes a snapshot for spot and option, linear and inverse push deltas
+// which are merged into the last ticker before calling listeners
+func (p *PublicClient) GetTickerTopic(symbol string) (string, error) {
+	if symbol == "" {
+		return "", fmt.Errorf("the symbol field must be provided")
+	}
+
+	return "tickers." + symbol, nil
+}
+
+type KlineTopicParam struct {
+	Interval string `validate:"required,oneof=1 3 5 15 30 60 120 240 360 720 D W M"`
+	Symbol   string `validate:"required"`
+}
+
+func (p *PublicClient) GetKlineTopic(params *KlineTopicParam) (string, error) {
+	if p.category == "option" {
+		return "", fmt.Errorf("kline is not supported by option")
+	}
+
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("kline.%s.%s", params.Interval, params.Symbol), nil
+}
+
+// GetLiquidationTopic is supported by linear and inverse
+func (p *PublicClient) GetLiquidationTopic(symbol string) (string, error) {
+	if p.category != "linear" && p.category != "inverse" {
+		return "", fmt.Errorf("liquidation is not supported by %s", p.category)
+	}
+
+	if symbol == "" {
+		return "", fmt.Errorf("the symbol field must be provided")
+	}
+
+	return "liquidation." + symbol, nil
+}
+
+// GetLTKlineTopic subscribes to the kline of a leveraged token, it is supported by spot
+func (p *PublicClient) GetLTKlineTopic(params *KlineTopicParam) (string, error) {
+	if p.category != "spot" {
+		return "", fmt.Errorf("leveraged token kline is not supported by %s", p.category)
+	}
+
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("kline_lt.%s.%s", params.Interval, params.Symbol), nil
+}
+
+type CategoryTopicParam struct {
+	// Category is empty for all categories
+	Category string `validate:"omitempty,oneof=spot linear inverse option"`
+}
+
+func categoryTopic(channel string, params *CategoryTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	if params.Category == "" {
+		return channel, nil
+	}
+
+	return channel + "." + params.Category, nil
+}
+
+// GetPositionTopic is not supported by spot
+func (p *PrivateClient) GetPositionTopic(params *CategoryTopicParam) (string, error) {
+	if params.Category == "spot" {
+		return "", fmt.Errorf("position is not supported by spot")
+	}
+
+	return categoryTopic("position", params)
+}
+
+func (p *PrivateClient) GetExecutionTopic(params *CategoryTopicParam) (string, error) {
+	return categoryTopic("execution", params)
+}
+
+func (p *PrivateClient) GetOrderTopic(params *CategoryTopicParam) (string, error) {
+	return categoryTopic("order", params)
+}
+
+func (p *PrivateClient) GetWalletTopic() (string, error) {
+	return "wallet", nil
+}
+
+// GetGreeksTopic pushes the greeks of option positions by base coin
+func (p *PrivateClient) GetGreeksTopic() (string, error) {
+	return "greeks", nil
+}
+
+// GetDCPTopic pushes the disconnected cancel all protection status of product
+func (p *PrivateClient) GetDCPTopic(product string) (string, error) {
+	switch product {
+	case "future", "spot", "option":
+		return "dcp." + product, nil
+	default:
+		return "", fmt.Errorf("product must be one of future, spot, option")
+	}
+}
@@ -0,0 +1,342 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator"
+	resttypes "github.com/linstohu/nexapi/bybit/rest/types"
+	bybitutils "github.com/linstohu/nexapi/bybit/utils"
+	"github.com/linstohu/nexapi/bybit/websocket/types"
+	cmap "github.com/orcaman/concurrent-map/v2"
+)
+
+const (
+	OpCreateOrder       = "order.create"
+	OpAmendOrder        = "order.amend"
+	OpCancelOrder       = "order.cancel"
+	OpCreateBatchOrders = "order.create-batch"
+	OpAmendBatchOrders  = "order.amend-batch"
+	OpCancelBatchOrders = "order.cancel-batch"
+)
+
+// ErrDisconnected is returned to trade requests still waiting when the connection drops,
+// the order may or may not have reached bybit, check its state before sending it again.
+var ErrDisconnected = errors.New("connection closed before the response arrived")
+
+// OpError is returned when a trade response has a non-zero retCode,
+// the response is returned along with the error for the limit status.
+type OpError struct {
+	Op   string
+	Code int
+	Msg  string
+}
+
+func (e *OpError) Error() string {
+	return fmt.Sprintf("%s: op: %s, code: %d, msg: %s", logPrefix, e.Op, e.Code, e.Msg)
+}
+
+// TradeClient places, amends and cancels orders over the trade stream, it authenticates once on every connect
+type TradeClient struct {
+	*client
+	// validate struct fields
+	validate   *validator.Validate
+	recvWindow int
+
+	// orders holds trade requests waiting for the response with the same reqId
+	orders cmap.ConcurrentMap[string, chan *types.TradeMessage]
+}
+
+type TradeClientCfg struct {
+	Debug bool
+	// bybitutils.TradeWsURL or bybitutils.TestTradeWsURL
+	BaseURL       string `validate:"required"`
+	AutoReconnect bool   `validate:"required"`
+
+	Key        string `validate:"required"`
+	Secret     string `validate:"required"`
+	RecvWindow int
+
+	Logger *slog.Logger
+}
+
+func NewTradeClient(cfg *TradeClientCfg) (*TradeClient, error) {
+	validator := validator.New()
+
+	if err := validator.Struct(cfg); err != nil {
+		return nil, err
+	}
+
+	cli := &TradeClient{
+		client:     newClient(cfg.BaseURL, cfg.Key, cfg.Secret, cfg.Debug, cfg.AutoReconnect, cfg.Logger, nil),
+		validate:   validator,
+		recvWindow: cfg.RecvWindow,
+		orders:     cmap.New[chan *types.TradeMessage](),
+	}
+
+	cli.route = cli.routeMessage
+	cli.onClose = cli.failPending
+
+	return cli, nil
+}
+
+func (t *TradeClient) PlaceOrder(ctx context.Context, param resttypes.PlaceOrderParam) (*types.OrderResponse, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.orderRequest(ctx, OpCreateOrder, param)
+}
+
+func (t *TradeClient) AmendOrder(ctx context.Context, param resttypes.AmendOrderParam) (*types.OrderResponse, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.orderRequest(ctx, OpAmendOrder, param)
+}
+
+func (t *TradeClient) CancelOrder(ctx context.Context, param resttypes.CancelOrderParam) (*types.OrderResponse, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.orderRequest(ctx, OpCancelOrder, param)
+}
+
+// BatchPlaceOrders places up to 20 orders of linear and option, or 10 orders of spot at a time
+func (t *TradeClient) BatchPlaceOrders(ctx context.Context, param resttypes.BatchPlaceOrdersParam) (*types.BatchOrdersResponse, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.batchRequest(ctx, OpCreateBatchOrders, param)
+}
+
+func (t *TradeClient) BatchAmendOrders(ctx context.Context, param resttypes.BatchAmendOrdersParam) (*types.BatchOrdersResponse, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.batchRequest(ctx, OpAmendBatchOrders, param)
+}
+
+func (t *TradeClient) BatchCancelOrders(ctx context.Context, param resttypes.BatchCancelOrdersParam) (*types.BatchOrdersResponse, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.batchRequest(ctx, OpCancelBatchOrders, param)
+}
+
+func (t *TradeClient) orderRequest(ctx context.Context, op string, arg any) (*types.OrderResponse, error) {
+	msg, err := t.request(ctx, op, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.OrderResponse{
+		ReqId:   msg.ReqId,
+		RetCode: msg.RetCode,
+		RetMsg:  msg.RetMsg,
+		Limit:   parseLimitStatus(msg.Header),
+	}
+
+	if len(msg.Data) > 0 {
+		if err := json.Unmarshal(msg.Data, &resp.Result); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.RetCode != 0 {
+		return resp, &OpError{Op: msg.Op, Code: msg.RetCode, Msg: msg.RetMsg}
+	}
+
+	return resp, nil
+}
+
+func (t *TradeClient) batchRequest(ctx context.Context, op string, arg any) (*types.BatchOrdersResponse, error) {
+	msg, err := t.request(ctx, op, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.BatchOrdersResponse{
+		ReqId:   msg.ReqId,
+		RetCode: msg.RetCode,
+		RetMsg:  msg.RetMsg,
+		Limit:   parseLimitStatus(msg.Header),
+	}
+
+	var data struct {
+		List []resttypes.BatchOrderResult `json:"list"`
+	}
+	if len(msg.Data) > 0 {
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			return nil, err
+		}
+	}
+	resp.Result = data.List
+
+	var ext struct {
+		List []resttypes.BatchOrderStatus `json:"list"`
+	}
+	if len(msg.RetExtInfo) > 0 {
+		if err := json.Unmarshal(msg.RetExtInfo, &ext); err != nil {
+			return nil, err
+		}
+	}
+	resp.Status = ext.List
+
+	if msg.RetCode != 0 {
+		return resp, &OpError{Op: msg.Op, Code: msg.RetCode, Msg: msg.RetMsg}
+	}
+
+	return resp, nil
+}
+
+// request sends one op and waits for the response with the same reqId, RequestTimeout is applied
+// when ctx has no deadline
+func (t *TradeClient) request(ctx context.Context, op string, arg any) (*types.TradeMessage, error) {
+	id := t.nextReqID()
+
+	ch := make(chan *types.TradeMessage, 1)
+	t.orders.Set(id, ch)
+	defer t.orders.Remove(id)
+
+	header := map[string]string{
+		"X-BAPI-TIMESTAMP": strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}
+	if t.recvWindow > 0 {
+		header["X-BAPI-RECV-WINDOW"] = strconv.Itoa(t.recvWindow)
+	}
+
+	err := t.sendTrade(&types.TradeRequest{
+		ReqId:  id,
+		Header: header,
+		Op:     op,
+		Args:   []any{arg},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, RequestTimeout*time.Second)
+		defer cancel()
+	}
+
+	select {
+	case msg := <-ch:
+		if msg == nil {
+			return nil, fmt.Errorf("%s: op: %s, reqId: %s, %w", logPrefix, op, id, ErrDisconnected)
+		}
+		return msg, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s: wait for response, op: %s, reqId: %s, %w", logPrefix, op, id, ctx.Err())
+	}
+}
+
+// failPending wakes every waiting trade request with ErrDisconnected,
+// their responses will not arrive on the next connection
+func (t *TradeClient) failPending() {
+	for _, id := range t.orders.Keys() {
+		ch, ok := t.orders.Pop(id)
+		if !ok {
+			continue
+		}
+
+		// a nil message means disconnected, a response already buffered wins
+		select {
+		case ch <- nil:
+		default:
+		}
+	}
+}
+
+func (t *TradeClient) sendTrade(req *types.TradeRequest) error {
+	t.sending.Lock()
+	defer t.sending.Unlock()
+
+	if !t.IsConnected() {
+		return fmt.Errorf("connection is closed")
+	}
+
+	return t.conn.WriteJSON(req)
+}
+
+// routeMessage reads responses of the trade stream, which use reqId and retCode
+func (t *TradeClient) routeMessage(buf []byte) {
+	var msg types.TradeMessage
+	if err := json.Unmarshal(buf, &msg); err != nil {
+		t.logger.Info(fmt.Sprintf("%s: read object error, %s", logPrefix, err))
+		return
+	}
+
+	switch {
+	case msg.Op == bybitutils.WsAuth:
+		if msg.RetCode == 0 {
+			t.setAuthResult(nil)
+		} else {
+			t.setAuthResult(fmt.Errorf("auth failed, code: %d, msg: %s", msg.RetCode, msg.RetMsg))
+		}
+	case msg.Op == bybitutils.WsPing || msg.Op == bybitutils.WsPong:
+		return
+	case msg.ReqId != "":
+		ch, ok := t.orders.Get(msg.ReqId)
+		if !ok {
+			if t.debug {
+				t.logger.Info(fmt.Sprintf("%s: no pending request, op: %s, reqId: %s", logPrefix, msg.Op, msg.ReqId))
+			}
+			return
+		}
+
+		select {
+		case ch <- &msg:
+		default:
+		}
+	case msg.RetCode != 0:
+		t.logger.Error(fmt.Sprintf("%s: op: %s, code: %d, msg: %s", logPrefix, msg.Op, msg.RetCode, msg.RetMsg))
+	}
+}
+
+func parseLimitStatus(header map[string]string) types.LimitStatus {
+	var ret types.LimitStatus
+
+	ret.Limit, _ = strconv.Atoi(header["X-Bapi-Limit"])
+	ret.Remaining, _ = strconv.Atoi(header["X-Bapi-Limit-Status"])
+	ret.ResetTimestamp, _ = strconv.ParseInt(header["X-Bapi-Limit-Reset-Timestamp"], 10, 64)
+	ret.TraceId = header["Traceid"]
+	ret.TimeNow, _ = strconv.ParseInt(header["Timenow"], 10, 64)
+
+	return ret
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+type Position struct {
+	Category               string `json:"category"`
+	Symbol                 string `json:"symbol"`
+	Side                   string `json:"side"`
+	Size                   string `json:"size"`
+	PositionIdx            int    `json:"positionIdx"`
+	TradeMode              int    `json:"tradeMode"`
+	PositionValue          string `json:"positionValue"`
+	RiskId                 int    `json:"riskId"`
+	RiskLimitValue         string `json:"riskLimitValue"`
+	EntryPrice             string `json:"entryPrice"`
+	MarkPrice              string `json:"markPrice"`
+	Leverage               string `json:"leverage"`
+	PositionBalance        string `json:"positionBalance"`
+	AutoAddMargin          int    `json:"autoAddMargin"`
+	PositionIM             string `json:"positionIM"`
+	PositionMM             string `json:"positionMM"`
+	LiqPrice               string `json:"liqPrice"`
+	BustPrice              string `json:"bustPrice"`
+	TpslMode               string `json:"tpslMode"`
+	TakeProfit             string `json:"takeProfit"`
+	StopLoss               string `json:"stopLoss"`
+	TrailingStop           string `json:"trailingStop"`
+	UnrealisedPnl          string `json:"unrealisedPnl"`
+	CurRealisedPnl         string `json:"curRealisedPnl"`
+	CumRealisedPnl         string `json:"cumRealisedPnl"`
+	SessionAvgPrice        string `json:"sessionAvgPrice"`
+	Delta                  string `json:"delta"`
+	Gamma                  string `json:"gamma"`
+	Vega                   string `json:"vega"`
+	Theta                  string `json:"theta"`
+	PositionStatus         string `json:"positionStatus"`
+	AdlRankIndicator       int    `json:"adlRankIndicator"`
+	IsReduceOnly           bool   `json:"isReduceOnly"`
+	MmrSysUpdatedTime      string `json:"mmrSysUpdatedTime"`
+	LeverageSysUpdatedTime string `json:"leverageSysUpdatedTime"`
+	CreatedTime            string `json:"createdTime"`
+	UpdatedTime            string `json:"updatedTime"`
+	Seq                    int64  `json:"seq"`
+}
+
+type Execution struct {
+	Category        string `json:"category"`
+	Symbol          string `json:"symbol"`
+	IsLeverage      string `json:"isLeverage"`
+	OrderId         string `json:"orderId"`
+	OrderLinkId     string `json:"orderLinkId"`
+	Side            string `json:"side"`
+	OrderPrice      string `json:"orderPrice"`
+	OrderQty        string `json:"orderQty"`
+	LeavesQty       string `json:"leavesQty"`
+	CreateType      string `json:"createType"`
+	OrderType       string `json:"orderType"`
+	StopOrderType   string `json:"stopOrderType"`
+	ExecFee         string `json:"execFee"`
+	ExecId          string `json:"execId"`
+	ExecPrice       string `json:"execPrice"`
+	ExecQty         string `json:"execQty"`
+	ExecType        string `json:"execType"`
+	ExecValue       string `json:"execValue"`
+	ExecTime        string `json:"execTime"`
+	IsMaker         bool   `json:"isMaker"`
+	FeeRate         string `json:"feeRate"`
+	TradeIv         string `json:"tradeIv"`
+	MarkIv          string `json:"markIv"`
+	MarkPrice       string `json:"markPrice"`
+	IndexPrice      string `json:"indexPrice"`
+	UnderlyingPrice string `json:"underlyingPrice"`
+	BlockTradeId    string `json:"blockTradeId"`
+	ClosedSize      string `json:"closedSize"`
+	Seq             int64  `json:"seq"`
+}
+
+type Order struct {
+	Category           string `json:"category"`
+	OrderId            string `json:"orderId"`
+	OrderLinkId        string `json:"orderLinkId"`
+	IsLeverage         string `json:"isLeverage"`
+	BlockTradeId       string `json:"blockTradeId"`
+	Symbol             string `json:"symbol"`
+	Price              string `json:"price"`
+	Qty                string `json:"qty"`
+	Side               string `json:"side"`
+	PositionIdx        int    `json:"positionIdx"`
+	OrderStatus        string `json:"orderStatus"`
+	CreateType         string `json:"createType"`
+	CancelType         string `json:"cancelType"`
+	RejectReason       string `json:"rejectReason"`
+	AvgPrice           string `json:"avgPrice"`
+	LeavesQty          string `json:"leavesQty"`
+	LeavesValue        string `json:"leavesValue"`
+	CumExecQty         string `json:"cumExecQty"`
+	CumExecValue       string `json:"cumExecValue"`
+	CumExecFee         string `json:"cumExecFee"`
+	FeeCurrency        string `json:"feeCurrency"`
+	TimeInForce        string `json:"timeInForce"`
+	OrderType          string `json:"orderType"`
+	StopOrderType      string `json:"stopOrderType"`
+	OcoTriggerBy       string `json:"ocoTriggerBy"`
+	OrderIv            string `json:"orderIv"`
+	MarketUnit         string `json:"marketUnit"`
+	TriggerPrice       string `json:"triggerPrice"`
+	TakeProfit         string `json:"takeProfit"`
+	StopLoss           string `json:"stopLoss"`
+	TpslMode           string `json:"tpslMode"`
+	TpLimitPrice       string `json:"tpLimitPrice"`
+	SlLimitPrice       string `json:"slLimitPrice"`
+	TpTriggerBy        string `json:"tpTriggerBy"`
+	SlTriggerBy        string `json:"slTriggerBy"`
+	TriggerDirection   int    `json:"triggerDirection"`
+	TriggerBy          string `json:"triggerBy"`
+	LastPriceOnCreated string `json:"lastPriceOnCreated"`
+	ReduceOnly         bool   `json:"reduceOnly"`
+	CloseOnTrigger     bool   `json:"closeOnTrigger"`
+	PlaceType          string `json:"placeType"`
+	SmpType            string `json:"smpType"`
+	SmpGroup           int    `json:"smpGroup"`
+	SmpOrderId         string `json:"smpOrderId"`
+	CreatedTime        string `json:"createdTime"`
+	UpdatedTime        string `json:"updatedTime"`
+}
+
+type Wallet struct {
+	AccountType            string       `json:"accountType"`
+	AccountIMRate          string       `json:"accountIMRate"`
+	AccountMMRate          string       `json:"accountMMRate"`
+	AccountLTV             string       `json:"accountLTV"`
+	TotalEquity            string       `json:"totalEquity"`
+	TotalWalletBalance     string       `json:"totalWalletBalance"`
+	TotalMarginBalance     string       `json:"totalMarginBalance"`
+	TotalAvailableBalance  string       `json:"totalAvailableBalance"`
+	TotalPerpUPL           string       `json:"totalPerpUPL"`
+	TotalInitialMargin     string       `json:"totalInitialMargin"`
+	TotalMaintenanceMargin string       `json:"totalMaintenanceMargin"`
+	Coin                   []WalletCoin `json:"coin"`
+}
+
+type WalletCoin struct {
+	Coin                string `json:"coin"`
+	Equity              string `json:"equity"`
+	UsdValue            string `json:"usdValue"`
+	WalletBalance       string `json:"walletBalance"`
+	AvailableToWithdraw string `json:"availableToWithdraw"`
+	AvailableToBorrow   string `json:"availableToBorrow"`
+	BorrowAmount        string `json:"borrowAmount"`
+	AccruedInterest     string `json:"accruedInterest"`
+	TotalOrderIM        string `json:"totalOrderIM"`
+	TotalPositionIM     string `json:"totalPositionIM"`
+	TotalPositionMM     string `json:"totalPositionMM"`
+	UnrealisedPnl       string `json:"unrealisedPnl"`
+	CumRealisedPnl      string `json:"cumRealisedPnl"`
+	Bonus               string `json:"bonus"`
+	CollateralSwitch    bool   `json:"collateralSwitch"`
+	MarginCollateral    bool   `json:"marginCollateral"`
+	Locked              string `json:"locked"`
+	SpotHedgingQty      string `json:"spotHedgingQty"`
+}
+
+type Greeks struct {
+	BaseCoin   string `json:"baseCoin"`
+	TotalDelta string `json:"totalDelta"`
+	TotalGamma string `json:"totalGamma"`
+	TotalVega  string `json:"totalVega"`
+	TotalTheta string `json:"totalTheta"`
+}
+
+// DCP is the status of disconnected cancel all protection
+type DCP struct {
+	Product    string `json:"product"`
+	DcpStatus  string `json:"dcpStatus"`
+	TimeWindow string `json:"timeWindow"`
+}
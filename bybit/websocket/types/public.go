@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+const (
+	Snapshot = "snapshot"
+	Delta    = "delta"
+)
+
+type PriceLevel struct {
+	Price string
+	Size  string
+}
+
+// OrderBook is the local order book after merging the snapshot and deltas,
+// bids are sorted from the highest price and asks from the lowest
+type OrderBook struct {
+	Symbol string
+	Bids   []PriceLevel
+	Asks   []PriceLevel
+	// UpdateId is 1 when the server restarts and pushes a new snapshot
+	UpdateId int64
+	Seq      int64
+	// Ts is the push time of the system, Cts is the matching engine time
+	Ts  int64
+	Cts int64
+}
+
+// OrderBookData is the raw snapshot or delta, a size of 0 in a delta deletes the price level
+type OrderBookData struct {
+	Symbol   string      `json:"s"`
+	Bids     [][2]string `json:"b"`
+	Asks     [][2]string `json:"a"`
+	UpdateId int64       `json:"u"`
+	Seq      int64       `json:"seq"`
+}
+
+type Trade struct {
+	Time          int64  `json:"T"`
+	Symbol        string `json:"s"`
+	Side          string `json:"S"`
+	Size          string `json:"v"`
+	Price         string `json:"p"`
+	TickDirection string `json:"L"`
+	TradeId       string `json:"i"`
+	BlockTrade    bool   `json:"BT"`
+	// option only
+	MarkPrice  string `json:"mP"`
+	IndexPrice string `json:"iP"`
+	MarkIv     string `json:"mIv"`
+	Iv         string `json:"iv"`
+}
+
+type SpotTicker struct {
+	Symbol        string `json:"symbol"`
+	LastPrice     string `json:"lastPrice"`
+	HighPrice24H  string `json:"highPrice24h"`
+	LowPrice24H   string `json:"lowPrice24h"`
+	PrevPrice24H  string `json:"prevPrice24h"`
+	Volume24H     string `json:"volume24h"`
+	Turnover24H   string `json:"turnover24h"`
+	Price24HPcnt  string `json:"price24hPcnt"`
+	UsdIndexPrice string `json:"usdIndexPrice"`
+}
+
+// FuturesTicker is the ticker of linear and inverse, deltas are merged into the last snapshot
+type FuturesTicker struct {
+	Symbol                 string `json:"symbol"`
+	TickDirection          string `json:"tickDirection"`
+	Price24HPcnt           string `json:"price24hPcnt"`
+	LastPrice              string `json:"lastPrice"`
+	PrevPrice24H           string `json:"prevPrice24h"`
+	HighPrice24H           string `json:"highPrice24h"`
+	LowPrice24H            string `json:"lowPrice24h"`
+	PrevPrice1H            string `json:"prevPrice1h"`
+	MarkPrice              string `json:"markPrice"`
+	IndexPrice             string `json:"indexPrice"`
+	OpenInterest           string `json:"openInterest"`
+	OpenInterestValue      string `json:"openInterestValue"`
+	Turnover24H            string `json:"turnover24h"`
+	Volume24H              string `json:"volume24h"`
+	NextFundingTime        string `json:"nextFundingTime"`
+	FundingRate            string `json:"fundingRate"`
+	Bid1Price              string `json:"bid1Price"`
+	Bid1Size               string `json:"bid1Size"`
+	Ask1Price              string `json:"ask1Price"`
+	Ask1Size               string `json:"ask1Size"`
+	DeliveryTime           string `json:"deliveryTime"`
+	BasisRate              string `json:"basisRate"`
+	DeliveryFeeRate        string `json:"deliveryFeeRate"`
+	PredictedDeliveryPrice string `json:"predictedDeliveryPrice"`
+}
+
+type OptionTicker struct {
+	Symbol                 string `json:"symbol"`
+	BidPrice               string `json:"bidPrice"`
+	BidSize                string `json:"bidSize"`
+	BidIv                  string `json:"bidIv"`
+	AskPrice               string `json:"askPrice"`
+	AskSize                string `json:"askSize"`
+	AskIv                  string `json:"askIv"`
+	LastPrice              string `json:"lastPrice"`
+	HighPrice24H           string `json:"highPrice24h"`
+	LowPrice24H            string `json:"lowPrice24h"`
+	MarkPrice              string `json:"markPrice"`
+	IndexPrice             string `json:"indexPrice"`
+	MarkPriceIv            string `json:"markPriceIv"`
+	UnderlyingPrice        string `json:"underlyingPrice"`
+	OpenInterest           string `json:"openInterest"`
+	Turnover24H            string `json:"turnover24h"`
+	Volume24H              string `json:"volume24h"`
+	TotalVolume            string `json:"totalVolume"`
+	TotalTurnover          string `json:"totalTurnover"`
+	Delta                  string `json:"delta"`
+	Gamma                  string `json:"gamma"`
+	Vega                   string `json:"vega"`
+	Theta                  string `json:"theta"`
+	PredictedDeliveryPrice string `json:"predictedDeliveryPrice"`
+	Change24H              string `json:"change24h"`
+}
+
+type Kline struct {
+	Start     int64  `json:"start"`
+	End       int64  `json:"end"`
+	Interval  string `json:"interval"`
+	Open      string `json:"open"`
+	Close     string `json:"close"`
+	High      string `json:"high"`
+	Low       string `json:"low"`
+	Volume    string `json:"volume"`
+	Turnover  string `json:"turnover"`
+	Confirm   bool   `json:"confirm"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+type Liquidation struct {
+	UpdatedTime int64  `json:"updatedTime"`
+	Symbol      string `json:"symbol"`
+	Side        string `json:"side"`
+	Size        string `json:"size"`
+	Price       string `json:"price"`
+}
+
+// LTKline is the kline of leveraged tokens
+type LTKline struct {
+	Start     int64  `json:"start"`
+	End       int64  `json:"end"`
+	Interval  string `json:"interval"`
+	Open      string `json:"open"`
+	Close     string `json:"close"`
+	High      string `json:"high"`
+	Low       string `json:"low"`
+	Confirm   bool   `json:"confirm"`
+	Timestamp int64  `json:"timestamp"`
+}
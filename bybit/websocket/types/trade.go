@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/json"
+
+	resttypes "github.com/linstohu/nexapi/bybit/rest/types"
+)
+
+type TradeRequest struct {
+	ReqId  string            `json:"reqId"`
+	Header map[string]string `json:"header"`
+	Op     string            `json:"op"`
+	Args   []any             `json:"args"`
+}
+
+type TradeMessage struct {
+	ReqId      string            `json:"reqId"`
+	RetCode    int               `json:"retCode"`
+	RetMsg     string            `json:"retMsg"`
+	Op         string            `json:"op"`
+	Data       json.RawMessage   `json:"data"`
+	RetExtInfo json.RawMessage   `json:"retExtInfo"`
+	Header     map[string]string `json:"header"`
+	ConnId     string            `json:"connId"`
+}
+
+// LimitStatus is the rate limit status in the header of a trade response
+type LimitStatus struct {
+	// Limit is the limit of the current period
+	Limit int
+	// Remaining is the remaining requests of the current period
+	Remaining int
+	// ResetTimestamp is when the limit resets, in milliseconds
+	ResetTimestamp int64
+	TraceId        string
+	TimeNow        int64
+}
+
+type OrderResponse struct {
+	ReqId   string
+	RetCode int
+	RetMsg  string
+	Result  resttypes.OrderResult
+	Limit   LimitStatus
+}
+
+// BatchOrdersResponse, the status of each order is in Status with the same index as Result
+type BatchOrdersResponse struct {
+	ReqId   string
+	RetCode int
+	RetMsg  string
+	Result  []resttypes.BatchOrderResult
+	Status  []resttypes.BatchOrderStatus
+	Limit   LimitStatus
+}
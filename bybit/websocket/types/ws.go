@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "encoding/json"
+
+type Request struct {
+	ReqId string `json:"req_id,omitempty"`
+	Op    string `json:"op"`
+	Args  []any  `json:"args,omitempty"`
+}
+
+// Message is either an op response, which carries ReqId and Op, or a data push, which carries Topic
+type Message struct {
+	Success bool   `json:"success"`
+	RetMsg  string `json:"ret_msg"`
+	ConnId  string `json:"conn_id"`
+	ReqId   string `json:"req_id"`
+	Op      string `json:"op"`
+
+	Id           string          `json:"id"`
+	Topic        string          `json:"topic"`
+	Type         string          `json:"type"`
+	Ts           int64           `json:"ts"`
+	Cts          int64           `json:"cts"`
+	CreationTime int64           `json:"creationTime"`
+	Data         json.RawMessage `json:"data"`
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+const (
+	logPrefix = "bybit::websocket"
+)
+
+const (
+	MaxTryTimes = 5
+
+	// the connection is closed by server if there is no ping within 10 minutes, 20 seconds is recommended
+	HeartbeatInterval = 20
+
+	// AuthTimeout is how long to wait for the auth response, in seconds
+	AuthTimeout = 10
+
+	// AuthExpire is how long the auth signature is valid for, in seconds
+	AuthExpire = 10
+
+	// RequestTimeout is how long to wait for a subscribe or unsubscribe response, in seconds
+	RequestTimeout = 10
+
+	// MaxArgs is the max number of topics in one subscribe request of spot
+	MaxArgs = 10
+)
@@ -27,12 +27,13 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	validator "github.com/go-playground/validator/v10"
 	"github.com/google/go-querystring/query"
 	"github.com/linstohu/nexapi/deribit/rest/types"
-	"github.com/linstohu/nexapi/deribit/rest/types/auth"
 )
 
 type DeribitRestClient struct {
@@ -45,19 +46,36 @@ type DeribitRestClient struct {
 	// validate struct fields
 	validate *validator.Validate
 
+	scope        string
+	useSignature bool
+
 	auth struct {
-		token     string
-		expiresAt int64
+		mu           sync.RWMutex
+		token        string
+		refreshToken string
+		expiresAt    time.Time
 	}
+
+	stopRefresh chan struct{}
+	closeOnce   sync.Once
 }
 
 type DeribitRestClientCfg struct {
-	BaseURL string `validate:"required"`
+	BaseURL string `validate:"required_without=Testnet"`
+	// Testnet connects to TestNetBaseURL, it can not be used along with BaseURL
+	Testnet bool `validate:"excluded_with=BaseURL"`
 	Key     string
 	Secret  string
 	Debug   bool
 	// Logger
 	Logger *slog.Logger
+
+	// Scope requests a scoped token, e.g. "session:name trade:read_write"
+	Scope string
+	// UseSignature authenticates with the client_signature grant, the secret is never sent
+	UseSignature bool
+	// AutoRefresh renews the token in the background before it expires, call Close to stop it
+	AutoRefresh bool
 }
 
 func NewDeribitRestClient(cfg *DeribitRestClientCfg) (*DeribitRestClient, error) {
@@ -68,14 +86,22 @@ func NewDeribitRestClient(cfg *DeribitRestClientCfg) (*DeribitRestClient, error)
 		return nil, err
 	}
 
-	cli := DeribitRestClient{
-		baseURL: cfg.BaseURL,
+	baseURL := cfg.BaseURL
+	if cfg.Testnet {
+		baseURL = TestNetBaseURL
+	}
+
+	cli := &DeribitRestClient{
+		baseURL: baseURL,
 		key:     cfg.Key,
 		secret:  cfg.Secret,
 		debug:   cfg.Debug,
 		logger:  cfg.Logger,
 
 		validate: validator,
+
+		scope:        cfg.Scope,
+		useSignature: cfg.UseSignature,
 	}
 
 	if cli.logger == nil {
@@ -83,21 +109,29 @@ func NewDeribitRestClient(cfg *DeribitRestClientCfg) (*DeribitRestClient, error)
 	}
 
 	if cfg.Key != "" && cfg.Secret != "" {
-		token, err := cli.Auth(context.TODO(), auth.AuthParams{
-			GrantType:    "client_credentials",
-			ClientID:     cfg.Key,
-			ClientSecret: cfg.Secret,
-		})
-		if err != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), AuthTimeout*time.Second)
+		defer cancel()
+
+		if _, err := cli.checkAuth(ctx); err != nil {
 			return nil, fmt.Errorf("init private rest client failed, error: %v", err)
 		}
 
-		now := time.Now().Unix()
-		cli.auth.token = token.AccessToken
-		cli.auth.expiresAt = now + token.ExpiresIn - 5
+		if cfg.AutoRefresh {
+			cli.stopRefresh = make(chan struct{})
+			go cli.refreshLoop()
+		}
 	}
 
-	return &cli, nil
+	return cli, nil
+}
+
+// Close stops the background token refresh
+func (d *DeribitRestClient) Close() {
+	d.closeOnce.Do(func() {
+		if d.stopRefresh != nil {
+			close(d.stopRefresh)
+		}
+	})
 }
 
 func (d *DeribitRestClient) SendHTTPRequest(ctx context.Context, req types.HTTPRequest) ([]byte, error) {
@@ -159,8 +193,61 @@ func (d *DeribitRestClient) SendHTTPRequest(ctx context.Context, req types.HTTPR
 	buf.ReadFrom(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
+		// json-rpc errors come with a 400 status code, keep them typed so callers can check the code
+		var msg types.JsonrpcMessage
+		if err := json.Unmarshal(buf.Bytes(), &msg); err == nil && msg.Error != nil {
+			return nil, fmt.Errorf("API returned a non-200 status code: [%d] - %w", resp.StatusCode, msg.Error)
+		}
 		return nil, fmt.Errorf("API returned a non-200 status code: [%d] - [%s]", resp.StatusCode, buf.String())
 	}
 
 	return buf.Bytes(), nil
 }
+
+// Call sends any json-rpc method, e.g. "public/get_time" or "private/get_positions",
+// private methods are authenticated and the result is decoded into result when it is not nil.
+// Errors returned by deribit can be checked with errors.As against *types.JsonError.
+func (d *DeribitRestClient) Call(ctx context.Context, method string, params any, result any) error {
+	method = strings.TrimPrefix(method, "/")
+
+	headers := DefaultContentType
+	if strings.HasPrefix(method, "private/") {
+		token, err := d.checkAuth(ctx)
+		if err != nil {
+			return err
+		}
+		headers = genAuthHeaders(token)
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/" + method,
+		Method:  http.MethodPost,
+		Headers: headers,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  method,
+			Params:  params,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return err
+	}
+
+	if jsonMsg.Error != nil {
+		return jsonMsg.Error
+	}
+
+	if result == nil || len(jsonMsg.Result) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(jsonMsg.Result, result)
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linstohu/nexapi/deribit/rest/types/marketdata"
+)
+
+// GetAllVolatilityIndexData follows continuation backwards from EndTimestamp
+// until StartTimestamp is reached, the candles are returned in ascending order
+func (d *DeribitRestClient) GetAllVolatilityIndexData(ctx context.Context, param marketdata.GetVolatilityIndexDataParams) ([][]float64, error) {
+	var pages [][][]float64
+	total := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		resp, err := d.GetVolatilityIndexData(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, resp.Data)
+		total += len(resp.Data)
+
+		next := resp.Continuation
+		if next == nil || *next >= param.EndTimestamp || *next < param.StartTimestamp {
+			break
+		}
+		param.EndTimestamp = *next
+	}
+
+	all := make([][]float64, 0, total)
+	for i := len(pages) - 1; i >= 0; i-- {
+		all = append(all, pages[i]...)
+	}
+
+	return all, nil
+}
+
+// GetAllDeliveryPrices follows offset until records_total delivery prices are returned
+func (d *DeribitRestClient) GetAllDeliveryPrices(ctx context.Context, param marketdata.GetDeliveryPricesParams) ([]marketdata.DeliveryPrice, error) {
+	var all []marketdata.DeliveryPrice
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		resp, err := d.GetDeliveryPrices(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Data...)
+
+		if len(resp.Data) == 0 || param.Offset+len(resp.Data) >= resp.RecordsTotal {
+			return all, nil
+		}
+		param.Offset += len(resp.Data)
+	}
+}
+
+// GetAllLastSettlementsByCurrency follows continuation until all settlements are returned
+func (d *DeribitRestClient) GetAllLastSettlementsByCurrency(ctx context.Context, param marketdata.GetLastSettlementsByCurrencyParams) ([]marketdata.Settlement, error) {
+	return walkContinuation(ctx, param.Continuation, func(continuation string) ([]marketdata.Settlement, string, error) {
+		param.Continuation = continuation
+		resp, err := d.GetLastSettlementsByCurrency(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Settlements, resp.Continuation, nil
+	})
+}
+
+// GetAllLastSettlementsByInstrument follows continuation until all settlements are returned
+func (d *DeribitRestClient) GetAllLastSettlementsByInstrument(ctx context.Context, param marketdata.GetLastSettlementsByInstrumentParams) ([]marketdata.Settlement, error) {
+	return walkContinuation(ctx, param.Continuation, func(continuation string) ([]marketdata.Settlement, string, error) {
+		param.Continuation = continuation
+		resp, err := d.GetLastSettlementsByInstrument(ctx, param)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Settlements, resp.Continuation, nil
+	})
+}
+
+// GetAllLastTradesByCurrencyAndTime follows has_more forwards from StartTimestamp,
+// the sorting is forced to asc
+func (d *DeribitRestClient) GetAllLastTradesByCurrencyAndTime(ctx context.Context, param marketdata.GetLastTradesByCurrencyAndTimeParams) ([]marketdata.Trade, error) {
+	param.Sorting = "asc"
+	return walkTrades(ctx, param.StartTimestamp, func(start int64) (*marketdata.GetLastTradesResponse, error) {
+		param.StartTimestamp = start
+		return d.GetLastTradesByCurrencyAndTime(ctx, param)
+	})
+}
+
+// GetAllLastTradesByInstrumentAndTime follows has_more forwards from StartTimestamp,
+// the sorting is forced to asc
+func (d *DeribitRestClient) GetAllLastTradesByInstrumentAndTime(ctx context.Context, param marketdata.GetLastTradesByInstrumentAndTimeParams) ([]marketdata.Trade, error) {
+	param.Sorting = "asc"
+	return walkTrades(ctx, param.StartTimestamp, func(start int64) (*marketdata.GetLastTradesResponse, error) {
+		param.StartTimestamp = start
+		return d.GetLastTradesByInstrumentAndTime(ctx, param)
+	})
+}
+
+// walkContinuation stops on an empty or "none" continuation, which deribit returns on the last page
+func walkContinuation[T any](ctx context.Context, continuation string, fetch func(continuation string) ([]T, string, error)) ([]T, error) {
+	var all []T
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		list, next, err := fetch(continuation)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+
+		if next == "" || next == "none" || next == continuation {
+			return all, nil
+		}
+		continuation = next
+	}
+}
+
+// walkTrades restarts each page at the timestamp of the last trade,
+// trades sharing that timestamp are returned again and dropped by trade id
+func walkTrades(ctx context.Context, start int64, fetch func(start int64) (*marketdata.GetLastTradesResponse, error)) ([]marketdata.Trade, error) {
+	var all []marketdata.Trade
+	seen := make(map[string]struct{})
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		resp, err := fetch(start)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, v := range resp.Trades {
+			if _, ok := seen[v.TradeID]; ok {
+				continue
+			}
+			seen[v.TradeID] = struct{}{}
+			all = append(all, v)
+			added++
+		}
+
+		if !resp.HasMore || len(resp.Trades) == 0 {
+			return all, nil
+		}
+		if added == 0 {
+			return nil, fmt.Errorf("%s: more trades than count share timestamp %d, increase count", logPrefix, start)
+		}
+		start = resp.Trades[len(resp.Trades)-1].Timestamp
+	}
+}
@@ -19,12 +19,21 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/linstohu/nexapi/deribit/rest/types/account"
 	"github.com/linstohu/nexapi/deribit/rest/types/auth"
+	"github.com/linstohu/nexapi/deribit/rest/types/session"
 	"github.com/linstohu/nexapi/deribit/rest/types/trading"
+	"github.com/linstohu/nexapi/deribit/rest/types/wallet"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,6 +63,27 @@ func TestAuth(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestSignatureAuth(t *testing.T) {
+	deribit, err := NewDeribitRestClient(&DeribitRestClientCfg{
+		BaseURL:      BaseURL,
+		Debug:        true,
+		Key:          os.Getenv("DERIBIT_KEY"),
+		Secret:       os.Getenv("DERIBIT_SECRET"),
+		Scope:        "session:nexapi trade:read",
+		UseSignature: true,
+		AutoRefresh:  true,
+	})
+	if err != nil {
+		t.Fatalf("Could not create deribit client, %s", err)
+	}
+	defer deribit.Close()
+
+	_, err = deribit.GetAccountSummary(context.TODO(), account.GetAccountSummaryParams{
+		Currency: "BTC",
+	})
+	assert.Nil(t, err)
+}
+
 func TestGetAccountSummary(t *testing.T) {
 	deribit := testNewDeribitRestPrivateClient(t)
 
@@ -184,3 +214,149 @@ func TestGetSettlementHistoryByCurrency(t *testing.T) {
 	})
 	assert.Nil(t, err)
 }
+
+func TestGetOrderHistoryByCurrency(t *testing.T) {
+	deribit := testNewDeribitRestPrivateClient(t)
+
+	_, err := deribit.GetOrderHistoryByCurrency(context.TODO(), trading.GetOrderHistoryByCurrencyParams{
+		Currency: "BTC",
+		Kind:     "future",
+		Count:    10,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetMargins(t *testing.T) {
+	deribit := testNewDeribitRestPrivateClient(t)
+
+	_, err := deribit.GetMargins(context.TODO(), trading.GetMarginsParams{
+		InstrumentName: "BTC-PERPETUAL",
+		Amount:         10,
+		Price:          25000,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetMMPConfig(t *testing.T) {
+	deribit := testNewDeribitRestPrivateClient(t)
+
+	_, err := deribit.GetMMPConfig(context.TODO(), trading.MMPParams{
+		IndexName: "btc_usd",
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetDeposits(t *testing.T) {
+	deribit := testNewDeribitRestPrivateClient(t)
+
+	_, err := deribit.GetDeposits(context.TODO(), wallet.GetDepositsParams{
+		Currency: "BTC",
+		Count:    10,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetSubaccounts(t *testing.T) {
+	deribit := testNewDeribitRestPrivateClient(t)
+
+	_, err := deribit.GetSubaccounts(context.TODO(), account.GetSubaccountsParams{
+		WithPortfolio: true,
+	})
+	assert.Nil(t, err)
+}
+
+func TestListAPIKeys(t *testing.T) {
+	deribit := testNewDeribitRestPrivateClient(t)
+
+	_, err := deribit.ListAPIKeys(context.TODO())
+	assert.Nil(t, err)
+}
+
+func TestCancelOnDisconnect(t *testing.T) {
+	deribit := testNewDeribitRestPrivateClient(t)
+
+	_, err := deribit.EnableCancelOnDisconnect(context.TODO(), session.CancelOnDisconnectParams{
+		Scope: "account",
+	})
+	assert.Nil(t, err)
+
+	resp, err := deribit.GetCancelOnDisconnect(context.TODO(), session.CancelOnDisconnectParams{
+		Scope: "account",
+	})
+	assert.Nil(t, err)
+	assert.True(t, resp.Enabled)
+
+	_, err = deribit.DisableCancelOnDisconnect(context.TODO(), session.CancelOnDisconnectParams{
+		Scope: "account",
+	})
+	assert.Nil(t, err)
+}
+
+func TestCheckAuthSingleFlight(t *testing.T) {
+	var auths atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64 `json:"id"`
+			Method string `json:"method"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+
+		if req.Method != "public/auth" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		n := auths.Add(1)
+		// slow enough for the concurrent callers to pile up on the renewal
+		time.Sleep(50 * time.Millisecond)
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": JsonRPCVersion,
+			"id":      req.ID,
+			"result": auth.AuthResponse{
+				AccessToken: fmt.Sprintf("token-%d", n),
+				ExpiresIn:   900,
+			},
+		})
+	}))
+	defer srv.Close()
+
+	deribit, err := NewDeribitRestClient(&DeribitRestClientCfg{
+		BaseURL: srv.URL,
+		Key:     "key",
+		Secret:  "secret",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), auths.Load())
+
+	// valid tokens are served without renewal
+	token, err := deribit.checkAuth(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", token)
+
+	deribit.auth.mu.Lock()
+	deribit.auth.expiresAt = time.Now().Add(-time.Second)
+	deribit.auth.mu.Unlock()
+
+	var (
+		wg     sync.WaitGroup
+		tokens = make([]string, 50)
+	)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			token, err := deribit.checkAuth(context.TODO())
+			assert.Nil(t, err)
+			tokens[i] = token
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), auths.Load())
+	for _, v := range tokens {
+		assert.Equal(t, "token-2", v)
+	}
+}
@@ -20,15 +20,14 @@ package rest
 import (
 	"context"
 	"encoding/json"
-	"errors"
-	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/linstohu/nexapi/deribit/rest/types"
 	"github.com/linstohu/nexapi/deribit/rest/types/account"
 	"github.com/linstohu/nexapi/deribit/rest/types/auth"
+	"github.com/linstohu/nexapi/deribit/rest/types/session"
 	"github.com/linstohu/nexapi/deribit/rest/types/trading"
+	"github.com/linstohu/nexapi/deribit/rest/types/wallet"
 )
 
 func (d *DeribitRestClient) Auth(ctx context.Context, param auth.AuthParams) (*auth.AuthResponse, error) {
@@ -67,48 +66,16 @@ func (d *DeribitRestClient) Auth(ctx context.Context, param auth.AuthParams) (*a
 	return &ret, nil
 }
 
-var ErrAuth = errors.New("auth error, you should reinitialize client using key and secret")
-
-func (d *DeribitRestClient) checkAuth() error {
-	if d.auth.token == "" {
-		return ErrAuth
-	}
-
-	now := time.Now().Unix()
-	if now > d.auth.expiresAt {
-		token, err := d.Auth(context.TODO(), auth.AuthParams{
-			GrantType:    "client_credentials",
-			ClientID:     d.key,
-			ClientSecret: d.secret,
-		})
-		if err != nil {
-			return fmt.Errorf("auth failed, error: %v", err)
-		}
-
-		now := time.Now().Unix()
-		d.auth.token = token.AccessToken
-		d.auth.expiresAt = now + token.ExpiresIn - 5
-	}
-
-	return nil
-}
-
-func (d *DeribitRestClient) genAuthHeaders() map[string]string {
-	return map[string]string{
-		"Content-Type":  "application/json",
-		"Authorization": fmt.Sprintf("Bearer %s", d.auth.token),
-	}
-}
-
 func (d *DeribitRestClient) GetAccountSummary(ctx context.Context, param account.GetAccountSummaryParams) (*account.AccountSummary, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_account_summary",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_account_summary",
@@ -136,14 +103,15 @@ func (d *DeribitRestClient) GetAccountSummary(ctx context.Context, param account
 }
 
 func (d *DeribitRestClient) GetPositions(ctx context.Context, param account.GetPositionsParams) ([]*account.Position, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_positions",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_positions",
@@ -171,14 +139,15 @@ func (d *DeribitRestClient) GetPositions(ctx context.Context, param account.GetP
 }
 
 func (d *DeribitRestClient) GetTransactionLog(ctx context.Context, param account.GetTransactionLogParams) (*account.GetTransactionLogResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_transaction_log",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_transaction_log",
@@ -206,14 +175,15 @@ func (d *DeribitRestClient) GetTransactionLog(ctx context.Context, param account
 }
 
 func (d *DeribitRestClient) Buy(ctx context.Context, param trading.BuyParams) (*trading.BuyResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/buy",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/buy",
@@ -241,14 +211,15 @@ func (d *DeribitRestClient) Buy(ctx context.Context, param trading.BuyParams) (*
 }
 
 func (d *DeribitRestClient) Sell(ctx context.Context, param trading.SellParams) (*trading.SellResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/sell",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/sell",
@@ -276,14 +247,15 @@ func (d *DeribitRestClient) Sell(ctx context.Context, param trading.SellParams)
 }
 
 func (d *DeribitRestClient) Cancel(ctx context.Context, param trading.CancelParams) (*trading.Order, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/cancel",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/cancel",
@@ -311,14 +283,15 @@ func (d *DeribitRestClient) Cancel(ctx context.Context, param trading.CancelPara
 }
 
 func (d *DeribitRestClient) CancelAll(ctx context.Context) (int, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return 0, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/cancel_all",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/cancel_all",
@@ -345,14 +318,15 @@ func (d *DeribitRestClient) CancelAll(ctx context.Context) (int, error) {
 }
 
 func (d *DeribitRestClient) CancelAllByInstrument(ctx context.Context, param trading.CancelAllByInstrumentParams) (int, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return 0, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/cancel_all_by_instrument",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/cancel_all_by_instrument",
@@ -380,14 +354,15 @@ func (d *DeribitRestClient) CancelAllByInstrument(ctx context.Context, param tra
 }
 
 func (d *DeribitRestClient) ClosePosition(ctx context.Context, param trading.ClosePositionParams) (*trading.ClosePositionResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/close_position",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/close_position",
@@ -415,14 +390,15 @@ func (d *DeribitRestClient) ClosePosition(ctx context.Context, param trading.Clo
 }
 
 func (d *DeribitRestClient) GetOpenOrdersByCurrency(ctx context.Context, param trading.GetOpenOrdersByCurrencyParams) ([]*trading.Order, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_open_orders_by_currency",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_open_orders_by_currency",
@@ -450,14 +426,15 @@ func (d *DeribitRestClient) GetOpenOrdersByCurrency(ctx context.Context, param t
 }
 
 func (d *DeribitRestClient) GetOpenOrdersByInstrument(ctx context.Context, param trading.GetOpenOrdersByInstrumentParams) ([]*trading.Order, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_open_orders_by_instrument",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_open_orders_by_instrument",
@@ -485,14 +462,15 @@ func (d *DeribitRestClient) GetOpenOrdersByInstrument(ctx context.Context, param
 }
 
 func (d *DeribitRestClient) GetOrderState(ctx context.Context, param trading.GetOrderStateParams) (*trading.Order, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_order_state",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_order_state",
@@ -520,14 +498,15 @@ func (d *DeribitRestClient) GetOrderState(ctx context.Context, param trading.Get
 }
 
 func (d *DeribitRestClient) GetUserTradesByCurrency(ctx context.Context, param trading.GetUserTradesByCurrencyParams) (*trading.GetUserTradesResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_user_trades_by_currency",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_user_trades_by_currency",
@@ -555,14 +534,15 @@ func (d *DeribitRestClient) GetUserTradesByCurrency(ctx context.Context, param t
 }
 
 func (d *DeribitRestClient) GetUserTradesByCurrencyAndTime(ctx context.Context, param trading.GetUserTradesByCurrencyAndTimeParams) (*trading.GetUserTradesResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_user_trades_by_currency_and_time",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_user_trades_by_currency_and_time",
@@ -590,14 +570,15 @@ func (d *DeribitRestClient) GetUserTradesByCurrencyAndTime(ctx context.Context,
 }
 
 func (d *DeribitRestClient) GetUserTradesByInstrument(ctx context.Context, param trading.GetUserTradesByInstrumentParams) (*trading.GetUserTradesResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_user_trades_by_instrument",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_user_trades_by_instrument",
@@ -625,14 +606,15 @@ func (d *DeribitRestClient) GetUserTradesByInstrument(ctx context.Context, param
 }
 
 func (d *DeribitRestClient) GetUserTradesByInstrumentAndTime(ctx context.Context, param trading.GetUserTradesByInstrumentAndTimeParams) (*trading.GetUserTradesResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_user_trades_by_instrument_and_time",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_user_trades_by_instrument_and_time",
@@ -660,14 +642,15 @@ func (d *DeribitRestClient) GetUserTradesByInstrumentAndTime(ctx context.Context
 }
 
 func (d *DeribitRestClient) GetSettlementHistoryByInstrument(ctx context.Context, param trading.GetSettlementHistoryByInstrumentParams) (*trading.GetSettlementHistoryResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_settlement_history_by_instrument",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_settlement_history_by_instrument",
@@ -695,14 +678,15 @@ func (d *DeribitRestClient) GetSettlementHistoryByInstrument(ctx context.Context
 }
 
 func (d *DeribitRestClient) GetSettlementHistoryByCurrency(ctx context.Context, param trading.GetSettlementHistoryByCurrencyParams) (*trading.GetSettlementHistoryResponse, error) {
-	if err := d.checkAuth(); err != nil {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	req := types.HTTPRequest{
 		URL:     d.baseURL + "/api/v2/private/get_settlement_history_by_currency",
 		Method:  http.MethodPost,
-		Headers: d.genAuthHeaders(),
+		Headers: genAuthHeaders(token),
 		Body: &types.Body{
 			Jsonrpc: JsonRPCVersion,
 			Method:  "private/get_settlement_history_by_currency",
@@ -728,3 +712,1502 @@ func (d *DeribitRestClient) GetSettlementHistoryByCurrency(ctx context.Context,
 
 	return &ret, nil
 }
+
+func (d *DeribitRestClient) Edit(ctx context.Context, param trading.EditParams) (*trading.EditResponse, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/edit",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/edit",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret trading.EditResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+// EditByLabel edits the single open order with the given label on an instrument
+func (d *DeribitRestClient) EditByLabel(ctx context.Context, param trading.EditByLabelParams) (*trading.EditResponse, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/edit_by_label",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/edit_by_label",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret trading.EditResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) CancelByLabel(ctx context.Context, param trading.CancelByLabelParams) (int, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/cancel_by_label",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/cancel_by_label",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return 0, err
+	}
+
+	var ret int
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return 0, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) CancelQuotes(ctx context.Context, param trading.CancelQuotesParams) (int, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/cancel_quotes",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/cancel_quotes",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return 0, err
+	}
+
+	var ret int
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return 0, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetOrderMarginByIDs(ctx context.Context, param trading.GetOrderMarginByIDsParams) ([]*trading.OrderMargin, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_order_margin_by_ids",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_order_margin_by_ids",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*trading.OrderMargin
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetMargins(ctx context.Context, param trading.GetMarginsParams) (*trading.GetMarginsResponse, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_margins",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_margins",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret trading.GetMarginsResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetOrderHistoryByCurrency(ctx context.Context, param trading.GetOrderHistoryByCurrencyParams) ([]*trading.Order, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_order_history_by_currency",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_order_history_by_currency",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*trading.Order
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetOrderHistoryByInstrument(ctx context.Context, param trading.GetOrderHistoryByInstrumentParams) ([]*trading.Order, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_order_history_by_instrument",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_order_history_by_instrument",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*trading.Order
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetTriggerOrderHistory(ctx context.Context, param trading.GetTriggerOrderHistoryParams) (*trading.GetTriggerOrderHistoryResponse, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_trigger_order_history",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_trigger_order_history",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret trading.GetTriggerOrderHistoryResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) CreateCombo(ctx context.Context, param trading.CreateComboParams) (*trading.Combo, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/create_combo",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/create_combo",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret trading.Combo
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+// VerifyBlockTrade signs the block trade, the signature is passed on to the counterparty
+func (d *DeribitRestClient) VerifyBlockTrade(ctx context.Context, param trading.VerifyBlockTradeParams) (*trading.VerifyBlockTradeResponse, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/verify_block_trade",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/verify_block_trade",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret trading.VerifyBlockTradeResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) ExecuteBlockTrade(ctx context.Context, param trading.ExecuteBlockTradeParams) (*trading.BlockTrade, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/execute_block_trade",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/execute_block_trade",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret trading.BlockTrade
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) MassQuote(ctx context.Context, param trading.MassQuoteParams) (*trading.MassQuoteResponse, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/mass_quote",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/mass_quote",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret trading.MassQuoteResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetMMPConfig(ctx context.Context, param trading.MMPParams) ([]*trading.MMPConfig, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_mmp_config",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_mmp_config",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*trading.MMPConfig
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) SetMMPConfig(ctx context.Context, param trading.SetMMPConfigParams) ([]*trading.MMPConfig, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/set_mmp_config",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/set_mmp_config",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*trading.MMPConfig
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) ResetMMP(ctx context.Context, param trading.MMPParams) (string, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/reset_mmp",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/reset_mmp",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return "", err
+	}
+
+	var ret string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetMMPStatus(ctx context.Context, param trading.MMPParams) ([]*trading.MMPStatus, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_mmp_status",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_mmp_status",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*trading.MMPStatus
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetDeposits(ctx context.Context, param wallet.GetDepositsParams) (*wallet.GetDepositsResponse, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_deposits",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_deposits",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret wallet.GetDepositsResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetWithdrawals(ctx context.Context, param wallet.GetWithdrawalsParams) (*wallet.GetWithdrawalsResponse, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_withdrawals",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_withdrawals",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret wallet.GetWithdrawalsResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+// GetCurrentDepositAddress returns nil when no deposit address has been created yet
+func (d *DeribitRestClient) GetCurrentDepositAddress(ctx context.Context, param wallet.GetCurrentDepositAddressParams) (*wallet.DepositAddress, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_current_deposit_address",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_current_deposit_address",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret *wallet.DepositAddress
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) Withdraw(ctx context.Context, param wallet.WithdrawParams) (*wallet.Withdrawal, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/withdraw",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/withdraw",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret wallet.Withdrawal
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetTransfers(ctx context.Context, param wallet.GetTransfersParams) (*wallet.GetTransfersResponse, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_transfers",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_transfers",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret wallet.GetTransfersResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) SubmitTransferToSubaccount(ctx context.Context, param wallet.SubmitTransferToSubaccountParams) (*wallet.Transfer, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/submit_transfer_to_subaccount",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/submit_transfer_to_subaccount",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret wallet.Transfer
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) SubmitTransferToUser(ctx context.Context, param wallet.SubmitTransferToUserParams) (*wallet.Transfer, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/submit_transfer_to_user",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/submit_transfer_to_user",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret wallet.Transfer
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetSubaccounts(ctx context.Context, param account.GetSubaccountsParams) ([]*account.Subaccount, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_subaccounts",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_subaccounts",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*account.Subaccount
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetSubaccountsDetails(ctx context.Context, param account.GetSubaccountsDetailsParams) ([]*account.SubaccountDetails, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_subaccounts_details",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_subaccounts_details",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*account.SubaccountDetails
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) CreateSubaccount(ctx context.Context) (*account.Subaccount, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/create_subaccount",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/create_subaccount",
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret account.Subaccount
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) ChangeSubaccountName(ctx context.Context, param account.ChangeSubaccountNameParams) (string, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/change_subaccount_name",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/change_subaccount_name",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return "", err
+	}
+
+	var ret string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) ToggleSubaccountLogin(ctx context.Context, param account.ToggleSubaccountLoginParams) (string, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/toggle_subaccount_login",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/toggle_subaccount_login",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return "", err
+	}
+
+	var ret string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) CreateAPIKey(ctx context.Context, param account.CreateAPIKeyParams) (*account.APIKey, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/create_api_key",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/create_api_key",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret account.APIKey
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) ListAPIKeys(ctx context.Context) ([]*account.APIKey, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/list_api_keys",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/list_api_keys",
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*account.APIKey
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) EnableAPIKey(ctx context.Context, param account.APIKeyIDParams) (*account.APIKey, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/enable_api_key",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/enable_api_key",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret account.APIKey
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) DisableAPIKey(ctx context.Context, param account.APIKeyIDParams) (*account.APIKey, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/disable_api_key",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/disable_api_key",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret account.APIKey
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) RemoveAPIKey(ctx context.Context, param account.APIKeyIDParams) (string, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/remove_api_key",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/remove_api_key",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return "", err
+	}
+
+	var ret string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetEmailLanguage(ctx context.Context) (string, error) {
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_email_language",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_email_language",
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return "", err
+	}
+
+	var ret string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) SetEmailLanguage(ctx context.Context, param account.SetEmailLanguageParams) (string, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/set_email_language",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/set_email_language",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return "", err
+	}
+
+	var ret string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) EnableCancelOnDisconnect(ctx context.Context, param session.CancelOnDisconnectParams) (string, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/enable_cancel_on_disconnect",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/enable_cancel_on_disconnect",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return "", err
+	}
+
+	var ret string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) DisableCancelOnDisconnect(ctx context.Context, param session.CancelOnDisconnectParams) (string, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/disable_cancel_on_disconnect",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/disable_cancel_on_disconnect",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return "", err
+	}
+
+	var ret string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetCancelOnDisconnect(ctx context.Context, param session.CancelOnDisconnectParams) (*session.CancelOnDisconnect, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.checkAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/private/get_cancel_on_disconnect",
+		Method:  http.MethodPost,
+		Headers: genAuthHeaders(token),
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "private/get_cancel_on_disconnect",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret session.CancelOnDisconnect
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
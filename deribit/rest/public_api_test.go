@@ -21,7 +21,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/linstohu/nexapi/deribit/rest/types"
 	"github.com/linstohu/nexapi/deribit/rest/types/marketdata"
+	"github.com/linstohu/nexapi/deribit/rest/types/trading"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -158,3 +160,91 @@ func TestGetTicker(t *testing.T) {
 	})
 	assert.Nil(t, err)
 }
+
+func TestGetComboIDs(t *testing.T) {
+	deribit := testNewDeribitRestPublicClient(t)
+
+	_, err := deribit.GetComboIDs(context.TODO(), trading.GetComboIDsParams{
+		Currency: "BTC",
+		State:    "active",
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetAllVolatilityIndexData(t *testing.T) {
+	deribit := testNewDeribitRestPublicClient(t)
+
+	_, err := deribit.GetAllVolatilityIndexData(context.TODO(), marketdata.GetVolatilityIndexDataParams{
+		Currency:       "BTC",
+		StartTimestamp: 1696947123000,
+		EndTimestamp:   1696950723000,
+		Resolution:     "60",
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	deribit := testNewDeribitRestPublicClient(t)
+
+	_, err := deribit.GetFundingRateHistory(context.TODO(), marketdata.GetFundingRateParams{
+		InstrumentName: "BTC-PERPETUAL",
+		StartTimestamp: 1696947123000,
+		EndTimestamp:   1696950723000,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetAllLastSettlementsByCurrency(t *testing.T) {
+	deribit := testNewDeribitRestPublicClient(t)
+
+	_, err := deribit.GetAllLastSettlementsByCurrency(context.TODO(), marketdata.GetLastSettlementsByCurrencyParams{
+		Currency:             "BTC",
+		Type:                 "delivery",
+		Count:                100,
+		SearchStartTimestamp: 1696950723000,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetAllLastTradesByCurrencyAndTime(t *testing.T) {
+	deribit := testNewDeribitRestPublicClient(t)
+
+	_, err := deribit.GetAllLastTradesByCurrencyAndTime(context.TODO(), marketdata.GetLastTradesByCurrencyAndTimeParams{
+		Currency:       "ETH",
+		Kind:           "future",
+		StartTimestamp: 1696947123000,
+		EndTimestamp:   1696947723000,
+		Count:          1000,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetSupportedIndexNames(t *testing.T) {
+	deribit := testNewDeribitRestPublicClient(t)
+
+	_, err := deribit.GetSupportedIndexNames(context.TODO(), marketdata.GetSupportedIndexNamesParams{
+		Type: "all",
+	})
+	assert.Nil(t, err)
+}
+
+func TestCall(t *testing.T) {
+	deribit, err := NewDeribitRestClient(&DeribitRestClientCfg{
+		Testnet: true,
+		Debug:   true,
+	})
+	if err != nil {
+		t.Fatalf("Could not create deribit client, %s", err)
+	}
+
+	var ts int64
+	err = deribit.Call(context.TODO(), "public/get_time", nil, &ts)
+	assert.Nil(t, err)
+	assert.NotZero(t, ts)
+
+	err = deribit.Call(context.TODO(), "public/get_index_price", map[string]any{
+		"index_name": "unknown",
+	}, nil)
+	var jsonErr *types.JsonError
+	assert.ErrorAs(t, err, &jsonErr)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/linstohu/nexapi/deribit/rest/types"
 	"github.com/linstohu/nexapi/deribit/rest/types/marketdata"
 	"github.com/linstohu/nexapi/deribit/rest/types/supporting"
+	"github.com/linstohu/nexapi/deribit/rest/types/trading"
 )
 
 func (d *DeribitRestClient) Test(ctx context.Context) (*supporting.TestResponse, error) {
@@ -423,3 +424,438 @@ func (d *DeribitRestClient) GetTicker(ctx context.Context, param marketdata.Tick
 
 	return &ret, nil
 }
+
+func (d *DeribitRestClient) GetComboIDs(ctx context.Context, param trading.GetComboIDsParams) ([]string, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_combo_ids",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_combo_ids",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetVolatilityIndexData(ctx context.Context, param marketdata.GetVolatilityIndexDataParams) (*marketdata.GetVolatilityIndexDataResponse, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_volatility_index_data",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_volatility_index_data",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret marketdata.GetVolatilityIndexDataResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+// GetHistoricalVolatility returns [timestamp, volatility] pairs
+func (d *DeribitRestClient) GetHistoricalVolatility(ctx context.Context, param marketdata.GetHistoricalVolatilityParams) ([][]float64, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_historical_volatility",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_historical_volatility",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret [][]float64
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetDeliveryPrices(ctx context.Context, param marketdata.GetDeliveryPricesParams) (*marketdata.GetDeliveryPricesResponse, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_delivery_prices",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_delivery_prices",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret marketdata.GetDeliveryPricesResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetFundingChartData(ctx context.Context, param marketdata.GetFundingChartDataParams) (*marketdata.GetFundingChartDataResponse, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_funding_chart_data",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_funding_chart_data",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret marketdata.GetFundingChartDataResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetFundingRateHistory(ctx context.Context, param marketdata.GetFundingRateParams) ([]*marketdata.FundingRate, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_funding_rate_history",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_funding_rate_history",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []*marketdata.FundingRate
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetLastSettlementsByCurrency(ctx context.Context, param marketdata.GetLastSettlementsByCurrencyParams) (*marketdata.GetLastSettlementsResponse, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_last_settlements_by_currency",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_last_settlements_by_currency",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret marketdata.GetLastSettlementsResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetLastSettlementsByInstrument(ctx context.Context, param marketdata.GetLastSettlementsByInstrumentParams) (*marketdata.GetLastSettlementsResponse, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_last_settlements_by_instrument",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_last_settlements_by_instrument",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret marketdata.GetLastSettlementsResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetLastTradesByCurrency(ctx context.Context, param marketdata.GetLastTradesByCurrencyParams) (*marketdata.GetLastTradesResponse, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_last_trades_by_currency",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_last_trades_by_currency",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret marketdata.GetLastTradesResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (d *DeribitRestClient) GetLastTradesByCurrencyAndTime(ctx context.Context, param marketdata.GetLastTradesByCurrencyAndTimeParams) (*marketdata.GetLastTradesResponse, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_last_trades_by_currency_and_time",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_last_trades_by_currency_and_time",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret marketdata.GetLastTradesResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+// GetMarkPriceHistory returns [timestamp, mark price] pairs, only options are supported
+func (d *DeribitRestClient) GetMarkPriceHistory(ctx context.Context, param marketdata.GetMarkPriceHistoryParams) ([][]float64, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_mark_price_history",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_mark_price_history",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret [][]float64
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetIndexPriceNames(ctx context.Context) ([]string, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_index_price_names",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_index_price_names",
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetSupportedIndexNames(ctx context.Context, param marketdata.GetSupportedIndexNamesParams) ([]string, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_supported_index_names",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_supported_index_names",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (d *DeribitRestClient) GetOrderBookByInstrumentID(ctx context.Context, param marketdata.GetOrderBookByInstrumentIDParams) (*marketdata.GetOrderBookResponse, error) {
+	req := types.HTTPRequest{
+		URL:     d.baseURL + "/api/v2/public/get_order_book_by_instrument_id",
+		Method:  http.MethodPost,
+		Headers: DefaultContentType,
+		Body: &types.Body{
+			Jsonrpc: JsonRPCVersion,
+			Method:  "public/get_order_book_by_instrument_id",
+			Params:  param,
+		},
+		Debug: d.debug,
+	}
+
+	resp, err := d.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonMsg types.JsonrpcMessage
+	if err := json.Unmarshal(resp, &jsonMsg); err != nil {
+		return nil, err
+	}
+
+	var ret marketdata.GetOrderBookResponse
+	if err := json.Unmarshal(jsonMsg.Result, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/linstohu/nexapi/deribit/rest/types/auth"
+)
+
+var ErrAuth = errors.New("auth error, you should reinitialize client using key and secret")
+
+// checkAuth returns a valid access token, concurrent callers wait for the same renewal
+func (d *DeribitRestClient) checkAuth(ctx context.Context) (string, error) {
+	if d.key == "" || d.secret == "" {
+		return "", ErrAuth
+	}
+
+	d.auth.mu.RLock()
+	token, ok := d.validToken()
+	d.auth.mu.RUnlock()
+
+	if ok {
+		return token, nil
+	}
+
+	d.auth.mu.Lock()
+	defer d.auth.mu.Unlock()
+
+	// renewed by another caller while waiting for the lock
+	if token, ok := d.validToken(); ok {
+		return token, nil
+	}
+
+	if err := d.renew(ctx); err != nil {
+		return "", fmt.Errorf("auth failed, error: %v", err)
+	}
+
+	return d.auth.token, nil
+}
+
+// validToken returns the access token unless it has expired, d.auth.mu must be held
+func (d *DeribitRestClient) validToken() (string, bool) {
+	if d.auth.token == "" || !time.Now().Before(d.auth.expiresAt) {
+		return "", false
+	}
+
+	return d.auth.token, true
+}
+
+func genAuthHeaders(token string) map[string]string {
+	return map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": fmt.Sprintf("Bearer %s", token),
+	}
+}
+
+// renew uses the refresh token when there is one and falls back to the credentials, d.auth.mu must be held
+func (d *DeribitRestClient) renew(ctx context.Context) error {
+	if d.auth.refreshToken != "" {
+		token, err := d.Auth(ctx, *auth.NewRefresh(d.auth.refreshToken))
+		if err == nil {
+			d.setToken(token)
+			return nil
+		}
+
+		d.logger.Info(fmt.Sprintf("%s: refresh token failed, auth with credentials, error: %v", logPrefix, err))
+	}
+
+	param, err := d.credentials()
+	if err != nil {
+		return err
+	}
+
+	token, err := d.Auth(ctx, *param)
+	if err != nil {
+		return err
+	}
+
+	d.setToken(token)
+
+	return nil
+}
+
+// credentials returns client_signature params when UseSignature is set, which keeps the secret off the wire
+func (d *DeribitRestClient) credentials() (*auth.AuthParams, error) {
+	return auth.NewCredentials(d.key, d.secret, d.scope, d.useSignature)
+}
+
+func (d *DeribitRestClient) setToken(token *auth.AuthResponse) {
+	d.auth.token = token.AccessToken
+	d.auth.refreshToken = token.RefreshToken
+	d.auth.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn-5) * time.Second)
+}
+
+// refreshLoop renews the token RefreshBefore seconds before it expires until Close is called
+func (d *DeribitRestClient) refreshLoop() {
+	for {
+		d.auth.mu.RLock()
+		wait := time.Until(d.auth.expiresAt) - RefreshBefore*time.Second
+		d.auth.mu.RUnlock()
+
+		// retry a failed renewal after a short delay
+		if wait < RefreshRetry*time.Second {
+			wait = RefreshRetry * time.Second
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-d.stopRefresh:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), AuthTimeout*time.Second)
+		d.auth.mu.Lock()
+		err := d.renew(ctx)
+		d.auth.mu.Unlock()
+		cancel()
+
+		if err != nil {
+			d.logger.Error(fmt.Sprintf("%s: background refresh failed, error: %v", logPrefix, err))
+		}
+	}
+}
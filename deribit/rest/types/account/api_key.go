@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package account
+
+type CreateAPIKeyParams struct {
+	// MaxScope, e.g. "account:read trade:read_write"
+	MaxScope string `json:"max_scope" validate:"required"`
+	Name     string `json:"name,omitempty"`
+	// PublicKey is set for keys signed with ED25519 or RSA instead of a client secret
+	PublicKey       string   `json:"public_key,omitempty"`
+	EnabledFeatures []string `json:"enabled_features,omitempty"`
+}
+
+type APIKeyIDParams struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+type APIKey struct {
+	ClientID        string   `json:"client_id"`
+	ClientSecret    string   `json:"client_secret"`
+	Default         bool     `json:"default"`
+	Enabled         bool     `json:"enabled"`
+	EnabledFeatures []string `json:"enabled_features"`
+	ID              int64    `json:"id"`
+	MaxScope        string   `json:"max_scope"`
+	Name            string   `json:"name"`
+	PublicKey       string   `json:"public_key"`
+	Timestamp       int64    `json:"timestamp"`
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package account
+
+import "github.com/linstohu/nexapi/deribit/rest/types/trading"
+
+type GetSubaccountsParams struct {
+	WithPortfolio bool `json:"with_portfolio,omitempty"`
+}
+
+type Subaccount struct {
+	Email                string                          `json:"email"`
+	ID                   int64                           `json:"id"`
+	IsPassword           bool                            `json:"is_password"`
+	LoginEnabled         bool                            `json:"login_enabled"`
+	NotConfirmedEmail    string                          `json:"not_confirmed_email"`
+	Portfolio            map[string]*SubaccountPortfolio `json:"portfolio"`
+	ReceiveNotifications bool                            `json:"receive_notifications"`
+	SystemName           string                          `json:"system_name"`
+	TfaEnabled           bool                            `json:"tfa_enabled"`
+	Type                 string                          `json:"type"`
+	Username             string                          `json:"username"`
+}
+
+type SubaccountPortfolio struct {
+	AvailableFunds           float64 `json:"available_funds"`
+	AvailableWithdrawalFunds float64 `json:"available_withdrawal_funds"`
+	Balance                  float64 `json:"balance"`
+	Currency                 string  `json:"currency"`
+	Equity                   float64 `json:"equity"`
+	InitialMargin            float64 `json:"initial_margin"`
+	MaintenanceMargin        float64 `json:"maintenance_margin"`
+	MarginBalance            float64 `json:"margin_balance"`
+}
+
+type GetSubaccountsDetailsParams struct {
+	Currency       string `json:"currency" validate:"required"`
+	WithOpenOrders bool   `json:"with_open_orders,omitempty"`
+}
+
+type SubaccountDetails struct {
+	UID        int64            `json:"uid"`
+	Positions  []*Position      `json:"positions"`
+	OpenOrders []*trading.Order `json:"open_orders"`
+}
+
+type ChangeSubaccountNameParams struct {
+	SID  int64  `json:"sid" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+type ToggleSubaccountLoginParams struct {
+	SID   int64  `json:"sid" validate:"required"`
+	State string `json:"state" validate:"required,oneof=enable disable"`
+}
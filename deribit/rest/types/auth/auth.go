@@ -17,10 +17,29 @@
 
 package auth
 
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// AuthParams, client_signature signs with ClientSecret locally so the secret is never sent,
+// Scope requests a scoped token, e.g. "session:name trade:read_write"
+// doc: https://docs.deribit.com/#public-auth
 type AuthParams struct {
-	GrantType    string `json:"grant_type" validate:"required"`
-	ClientID     string `json:"client_id" validate:"required"`
-	ClientSecret string `json:"client_secret" validate:"required"`
+	GrantType    string `json:"grant_type" validate:"required,oneof=client_credentials client_signature refresh_token"`
+	ClientID     string `json:"client_id,omitempty" validate:"required_unless=GrantType refresh_token"`
+	ClientSecret string `json:"client_secret,omitempty" validate:"required_if=GrantType client_credentials"`
+	RefreshToken string `json:"refresh_token,omitempty" validate:"required_if=GrantType refresh_token"`
+	Timestamp    int64  `json:"timestamp,omitempty" validate:"required_if=GrantType client_signature"`
+	Signature    string `json:"signature,omitempty" validate:"required_if=GrantType client_signature"`
+	Nonce        string `json:"nonce,omitempty"`
+	Data         string `json:"data,omitempty"`
+	State        string `json:"state,omitempty"`
+	Scope        string `json:"scope,omitempty"`
 }
 
 type AuthResponse struct {
@@ -31,3 +50,43 @@ type AuthResponse struct {
 	State        string `json:"state"`
 	TokenType    string `json:"token_type"`
 }
+
+// NewCredentials returns client_credentials params, or client_signature params when signature is set,
+// which keeps the secret off the wire
+func NewCredentials(key, secret, scope string, signature bool) (*AuthParams, error) {
+	if !signature {
+		return &AuthParams{
+			GrantType:    "client_credentials",
+			ClientID:     key,
+			ClientSecret: secret,
+			Scope:        scope,
+		}, nil
+	}
+
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	nonce := hex.EncodeToString(buf)
+	timestamp := time.Now().UnixMilli()
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(strconv.FormatInt(timestamp, 10) + "\n" + nonce + "\n"))
+
+	return &AuthParams{
+		GrantType: "client_signature",
+		ClientID:  key,
+		Timestamp: timestamp,
+		Signature: hex.EncodeToString(h.Sum(nil)),
+		Nonce:     nonce,
+		Scope:     scope,
+	}, nil
+}
+
+// NewRefresh returns refresh_token params
+func NewRefresh(refreshToken string) *AuthParams {
+	return &AuthParams{
+		GrantType:    "refresh_token",
+		RefreshToken: refreshToken,
+	}
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package marketdata
+
+type GetDeliveryPricesParams struct {
+	IndexName string `json:"index_name"`
+	Offset    int    `json:"offset,omitempty"`
+	Count     int    `json:"count,omitempty"`
+}
+
+type GetDeliveryPricesResponse struct {
+	Data         []DeliveryPrice `json:"data"`
+	RecordsTotal int             `json:"records_total"`
+}
+
+type DeliveryPrice struct {
+	Date          string  `json:"date"`
+	DeliveryPrice float64 `json:"delivery_price"`
+}
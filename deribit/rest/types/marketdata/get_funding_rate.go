@@ -22,3 +22,29 @@ type GetFundingRateParams struct {
 	StartTimestamp int64  `json:"start_timestamp"`
 	EndTimestamp   int64  `json:"end_timestamp"`
 }
+
+type GetFundingChartDataParams struct {
+	InstrumentName string `json:"instrument_name"`
+	// Length is one of 8h, 24h and 1m
+	Length string `json:"length"`
+}
+
+type GetFundingChartDataResponse struct {
+	CurrentInterest float64            `json:"current_interest"`
+	Interest8H      float64            `json:"interest_8h"`
+	Data            []FundingChartData `json:"data"`
+}
+
+type FundingChartData struct {
+	IndexPrice float64 `json:"index_price"`
+	Interest8H float64 `json:"interest_8h"`
+	Timestamp  int64   `json:"timestamp"`
+}
+
+type FundingRate struct {
+	IndexPrice     float64 `json:"index_price"`
+	Interest1H     float64 `json:"interest_1h"`
+	Interest8H     float64 `json:"interest_8h"`
+	PrevIndexPrice float64 `json:"prev_index_price"`
+	Timestamp      int64   `json:"timestamp"`
+}
@@ -25,3 +25,8 @@ type GetIndexPriceResponse struct {
 	EstimatedDeliveryPrice float64 `json:"estimated_delivery_price"`
 	IndexPrice             float64 `json:"index_price"`
 }
+
+type GetSupportedIndexNamesParams struct {
+	// Type is one of all, spot and derivative
+	Type string `json:"type,omitempty"`
+}
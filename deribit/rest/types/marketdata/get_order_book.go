@@ -48,3 +48,8 @@ type GetOrderBookResponse struct {
 	BestAskAmount   float64     `json:"best_ask_amount"`
 	Asks            [][]float64 `json:"asks"`
 }
+
+type GetOrderBookByInstrumentIDParams struct {
+	InstrumentID int64 `json:"instrument_id"`
+	Depth        int   `json:"depth,omitempty"`
+}
@@ -37,9 +37,29 @@ type GetLastTradesResponse struct {
 
 type GetLastTradesByInstrumentAndTimeParams struct {
 	InstrumentName string `json:"instrument_name"`
-	StartTimestamp int    `json:"start_timestamp"`
-	EndTimestamp   int    `json:"end_timestamp"`
+	StartTimestamp int64  `json:"start_timestamp"`
+	EndTimestamp   int64  `json:"end_timestamp"`
 	Count          int    `json:"count,omitempty"`
 	IncludeOld     bool   `json:"include_old,omitempty"`
 	Sorting        string `json:"sorting,omitempty"`
 }
+
+type GetLastTradesByCurrencyParams struct {
+	Currency       string `json:"currency"`
+	Kind           string `json:"kind,omitempty"`
+	StartID        string `json:"start_id,omitempty"`
+	EndID          string `json:"end_id,omitempty"`
+	StartTimestamp int64  `json:"start_timestamp,omitempty"`
+	EndTimestamp   int64  `json:"end_timestamp,omitempty"`
+	Count          int    `json:"count,omitempty"`
+	Sorting        string `json:"sorting,omitempty"`
+}
+
+type GetLastTradesByCurrencyAndTimeParams struct {
+	Currency       string `json:"currency"`
+	Kind           string `json:"kind,omitempty"`
+	StartTimestamp int64  `json:"start_timestamp"`
+	EndTimestamp   int64  `json:"end_timestamp"`
+	Count          int    `json:"count,omitempty"`
+	Sorting        string `json:"sorting,omitempty"`
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package marketdata
+
+type GetVolatilityIndexDataParams struct {
+	Currency       string `json:"currency"`
+	StartTimestamp int64  `json:"start_timestamp"`
+	EndTimestamp   int64  `json:"end_timestamp"`
+	// Resolution is one of 1, 60, 3600, 43200 and 1D
+	Resolution string `json:"resolution"`
+}
+
+type GetVolatilityIndexDataResponse struct {
+	// Data holds candles as [timestamp, open, high, low, close]
+	Data [][]float64 `json:"data"`
+	// Continuation is the end_timestamp of the next page, it is nil on the last page
+	Continuation *int64 `json:"continuation"`
+}
+
+type GetHistoricalVolatilityParams struct {
+	Currency string `json:"currency"`
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trading
+
+type BlockTradeLeg struct {
+	InstrumentName string  `json:"instrument_name"`
+	Price          float64 `json:"price"`
+	Amount         float64 `json:"amount,omitempty"`
+	Direction      string  `json:"direction"`
+}
+
+type VerifyBlockTradeParams struct {
+	Timestamp int64  `json:"timestamp"`
+	Nonce     string `json:"nonce"`
+	// Role is either maker or taker
+	Role   string          `json:"role"`
+	Trades []BlockTradeLeg `json:"trades"`
+}
+
+type VerifyBlockTradeResponse struct {
+	Signature string `json:"signature"`
+}
+
+type ExecuteBlockTradeParams struct {
+	Timestamp             int64           `json:"timestamp"`
+	Nonce                 string          `json:"nonce"`
+	Role                  string          `json:"role"`
+	Trades                []BlockTradeLeg `json:"trades"`
+	CounterpartySignature string          `json:"counterparty_signature"`
+}
+
+type BlockTrade struct {
+	ID         string  `json:"id"`
+	Timestamp  int64   `json:"timestamp"`
+	AppName    string  `json:"app_name"`
+	BrokerCode string  `json:"broker_code"`
+	BrokerName string  `json:"broker_name"`
+	Trades     []Trade `json:"trades"`
+}
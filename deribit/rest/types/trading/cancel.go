@@ -25,3 +25,21 @@ type CancelAllByInstrumentParams struct {
 	InstrumentName string `json:"instrument_name"`
 	Type           string `json:"type,omitempty"`
 }
+
+type CancelByLabelParams struct {
+	Label    string `json:"label"`
+	Currency string `json:"currency,omitempty"`
+}
+
+type CancelQuotesParams struct {
+	// CancelType is one of delta, quote_set_id, instrument, instrument_kind, currency and all
+	CancelType     string  `json:"cancel_type"`
+	MinDelta       float64 `json:"min_delta,omitempty"`
+	MaxDelta       float64 `json:"max_delta,omitempty"`
+	QuoteSetID     string  `json:"quote_set_id,omitempty"`
+	InstrumentName string  `json:"instrument_name,omitempty"`
+	Kind           string  `json:"kind,omitempty"`
+	Currency       string  `json:"currency,omitempty"`
+	Detailed       bool    `json:"detailed,omitempty"`
+	FreezeQuotes   bool    `json:"freeze_quotes,omitempty"`
+}
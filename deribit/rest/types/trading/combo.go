@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trading
+
+type ComboTrade struct {
+	InstrumentName string  `json:"instrument_name"`
+	Amount         float64 `json:"amount,omitempty"`
+	Direction      string  `json:"direction"`
+}
+
+type CreateComboParams struct {
+	Trades []ComboTrade `json:"trades"`
+}
+
+type Combo struct {
+	ID                string     `json:"id"`
+	InstrumentID      int64      `json:"instrument_id"`
+	State             string     `json:"state"`
+	StateTimestamp    int64      `json:"state_timestamp"`
+	CreationTimestamp int64      `json:"creation_timestamp"`
+	Legs              []ComboLeg `json:"legs"`
+}
+
+type ComboLeg struct {
+	InstrumentName string  `json:"instrument_name"`
+	Amount         float64 `json:"amount"`
+}
+
+type GetComboIDsParams struct {
+	Currency string `json:"currency"`
+	// State is one of rfq, active and inactive
+	State string `json:"state,omitempty"`
+}
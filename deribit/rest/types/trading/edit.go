@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trading
+
+type EditParams struct {
+	OrderID        string  `json:"order_id"`
+	Amount         float64 `json:"amount,omitempty"`
+	Contracts      float64 `json:"contracts,omitempty"`
+	Price          float64 `json:"price,omitempty"`
+	PostOnly       bool    `json:"post_only,omitempty"`
+	ReduceOnly     bool    `json:"reduce_only,omitempty"`
+	RejectPostOnly bool    `json:"reject_post_only,omitempty"`
+	Advanced       string  `json:"advanced,omitempty"`
+	TriggerPrice   float64 `json:"trigger_price,omitempty"`
+	TriggerOffset  float64 `json:"trigger_offset,omitempty"`
+	MMP            bool    `json:"mmp,omitempty"`
+	ValidUntil     int64   `json:"valid_until,omitempty"`
+}
+
+type EditByLabelParams struct {
+	Label          string  `json:"label"`
+	InstrumentName string  `json:"instrument_name"`
+	Amount         float64 `json:"amount,omitempty"`
+	Contracts      float64 `json:"contracts,omitempty"`
+	Price          float64 `json:"price,omitempty"`
+	PostOnly       bool    `json:"post_only,omitempty"`
+	ReduceOnly     bool    `json:"reduce_only,omitempty"`
+	RejectPostOnly bool    `json:"reject_post_only,omitempty"`
+	Advanced       string  `json:"advanced,omitempty"`
+	TriggerPrice   float64 `json:"trigger_price,omitempty"`
+	MMP            bool    `json:"mmp,omitempty"`
+	ValidUntil     int64   `json:"valid_until,omitempty"`
+}
+
+type EditResponse struct {
+	Trades []Trade `json:"trades"`
+	Order  Order   `json:"order"`
+}
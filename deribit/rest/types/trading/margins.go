@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trading
+
+type GetOrderMarginByIDsParams struct {
+	IDs []string `json:"ids"`
+}
+
+type OrderMargin struct {
+	OrderID               string  `json:"order_id"`
+	InitialMargin         float64 `json:"initial_margin"`
+	InitialMarginCurrency string  `json:"initial_margin_currency"`
+}
+
+type GetMarginsParams struct {
+	InstrumentName string  `json:"instrument_name"`
+	Amount         float64 `json:"amount"`
+	Price          float64 `json:"price"`
+}
+
+type GetMarginsResponse struct {
+	Buy      float64 `json:"buy"`
+	Sell     float64 `json:"sell"`
+	MinPrice float64 `json:"min_price"`
+	MaxPrice float64 `json:"max_price"`
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trading
+
+type MassQuoteParams struct {
+	QuoteID    string       `json:"quote_id"`
+	MMPGroup   string       `json:"mmp_group"`
+	Detailed   bool         `json:"detailed,omitempty"`
+	ValidUntil int64        `json:"valid_until,omitempty"`
+	Quotes     []QuoteLevel `json:"quotes"`
+}
+
+type QuoteLevel struct {
+	InstrumentName string     `json:"instrument_name"`
+	Bid            *QuoteSide `json:"bid,omitempty"`
+	Ask            *QuoteSide `json:"ask,omitempty"`
+}
+
+type QuoteSide struct {
+	Price          float64 `json:"price"`
+	Amount         float64 `json:"amount"`
+	PostOnly       bool    `json:"post_only,omitempty"`
+	RejectPostOnly bool    `json:"reject_post_only,omitempty"`
+}
+
+// MassQuoteResponse carries the placed orders and the rejected quotes,
+// orders and errors are only filled in when MassQuoteParams.Detailed is set
+type MassQuoteResponse struct {
+	Orders []Order      `json:"orders"`
+	Errors []QuoteError `json:"errors"`
+	Trades []Trade      `json:"trades"`
+}
+
+type QuoteError struct {
+	InstrumentName string  `json:"instrument_name"`
+	Side           string  `json:"side"`
+	Price          float64 `json:"price"`
+	Amount         float64 `json:"amount"`
+	Code           int64   `json:"code"`
+	Message        string  `json:"message"`
+}
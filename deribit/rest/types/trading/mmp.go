@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trading
+
+type MMPParams struct {
+	IndexName string `json:"index_name,omitempty"`
+	MMPGroup  string `json:"mmp_group,omitempty"`
+}
+
+type SetMMPConfigParams struct {
+	IndexName string `json:"index_name"`
+	MMPGroup  string `json:"mmp_group,omitempty"`
+	// Interval is the monitoring window in seconds, 0 disables MMP
+	Interval         int     `json:"interval"`
+	FrozenTime       int     `json:"frozen_time"`
+	QuantityLimit    float64 `json:"quantity_limit,omitempty"`
+	DeltaLimit       float64 `json:"delta_limit,omitempty"`
+	VegaLimit        float64 `json:"vega_limit,omitempty"`
+	MaxQuoteQuantity float64 `json:"max_quote_quantity,omitempty"`
+}
+
+type MMPConfig struct {
+	IndexName        string  `json:"index_name"`
+	MMPGroup         string  `json:"mmp_group"`
+	Interval         int     `json:"interval"`
+	FrozenTime       int     `json:"frozen_time"`
+	QuantityLimit    float64 `json:"quantity_limit"`
+	DeltaLimit       float64 `json:"delta_limit"`
+	VegaLimit        float64 `json:"vega_limit"`
+	MaxQuoteQuantity float64 `json:"max_quote_quantity"`
+}
+
+type MMPStatus struct {
+	IndexName   string `json:"index_name"`
+	MMPGroup    string `json:"mmp_group"`
+	FrozenUntil int64  `json:"frozen_until"`
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trading
+
+type GetOrderHistoryByCurrencyParams struct {
+	Currency        string `json:"currency"`
+	Kind            string `json:"kind,omitempty"`
+	Count           int    `json:"count,omitempty"`
+	Offset          int    `json:"offset,omitempty"`
+	IncludeOld      bool   `json:"include_old,omitempty"`
+	IncludeUnfilled bool   `json:"include_unfilled,omitempty"`
+}
+
+type GetOrderHistoryByInstrumentParams struct {
+	InstrumentName  string `json:"instrument_name"`
+	Count           int    `json:"count,omitempty"`
+	Offset          int    `json:"offset,omitempty"`
+	IncludeOld      bool   `json:"include_old,omitempty"`
+	IncludeUnfilled bool   `json:"include_unfilled,omitempty"`
+}
+
+type GetTriggerOrderHistoryParams struct {
+	Currency       string `json:"currency"`
+	InstrumentName string `json:"instrument_name,omitempty"`
+	Count          int    `json:"count,omitempty"`
+	Continuation   string `json:"continuation,omitempty"`
+}
+
+type GetTriggerOrderHistoryResponse struct {
+	Entries      []TriggerOrderEntry `json:"entries"`
+	Continuation string              `json:"continuation"`
+}
+
+type TriggerOrderEntry struct {
+	Timestamp      int64   `json:"timestamp"`
+	Trigger        string  `json:"trigger"`
+	TriggerPrice   float64 `json:"trigger_price"`
+	TriggerOffset  float64 `json:"trigger_offset"`
+	TriggerOrderID string  `json:"trigger_order_id"`
+	OrderID        string  `json:"order_id"`
+	OrderState     string  `json:"order_state"`
+	OrderType      string  `json:"order_type"`
+	InstrumentName string  `json:"instrument_name"`
+	Direction      string  `json:"direction"`
+	Amount         float64 `json:"amount"`
+	Price          Price   `json:"price"`
+	Label          string  `json:"label"`
+	PostOnly       bool    `json:"post_only"`
+	ReduceOnly     bool    `json:"reduce_only"`
+	LastUpdate     int64   `json:"last_update_timestamp"`
+	Source         string  `json:"source"`
+	IsSecondaryOto bool    `json:"is_secondary_oto"`
+	OcoRef         string  `json:"oco_ref"`
+	Request        string  `json:"request"`
+}
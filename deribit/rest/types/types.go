@@ -49,11 +49,16 @@ type JsonrpcMessage struct {
 	TestNet bool            `json:"testnet,omitempty"`
 }
 
+// JsonError, Data carries the details of some errors, e.g. the invalid param and reason
 type JsonError struct {
-	Code    int64  `json:"code"`
-	Message string `json:"message"`
+	Code    int64           `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
 }
 
 func (e *JsonError) Error() string {
+	if len(e.Data) > 0 {
+		return fmt.Sprintf("json-rpc error (%d): %s, data: %s", e.Code, e.Message, e.Data)
+	}
 	return fmt.Sprintf("json-rpc error (%d): %s", e.Code, e.Message)
 }
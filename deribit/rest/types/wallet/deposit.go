@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wallet
+
+type GetDepositsParams struct {
+	Currency string `json:"currency" validate:"required"`
+	Count    int    `json:"count,omitempty" validate:"omitempty,min=1,max=1000"`
+	Offset   int    `json:"offset,omitempty"`
+}
+
+type GetDepositsResponse struct {
+	Count int        `json:"count"`
+	Data  []*Deposit `json:"data"`
+}
+
+type Deposit struct {
+	Address           string  `json:"address"`
+	Amount            float64 `json:"amount"`
+	Currency          string  `json:"currency"`
+	Note              string  `json:"note"`
+	ReceivedTimestamp int64   `json:"received_timestamp"`
+	State             string  `json:"state"`
+	TransactionID     string  `json:"transaction_id"`
+	UpdatedTimestamp  int64   `json:"updated_timestamp"`
+}
+
+type GetCurrentDepositAddressParams struct {
+	Currency string `json:"currency" validate:"required"`
+}
+
+type DepositAddress struct {
+	Address           string `json:"address"`
+	CreationTimestamp int64  `json:"creation_timestamp"`
+	Currency          string `json:"currency"`
+	Type              string `json:"type"`
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wallet
+
+type GetTransfersParams struct {
+	Currency string `json:"currency" validate:"required"`
+	Count    int    `json:"count,omitempty" validate:"omitempty,min=1,max=1000"`
+	Offset   int    `json:"offset,omitempty"`
+}
+
+type GetTransfersResponse struct {
+	Count int         `json:"count"`
+	Data  []*Transfer `json:"data"`
+}
+
+type Transfer struct {
+	Amount           float64 `json:"amount"`
+	CreatedTimestamp int64   `json:"created_timestamp"`
+	Currency         string  `json:"currency"`
+	Direction        string  `json:"direction"`
+	ID               int64   `json:"id"`
+	OtherSide        string  `json:"other_side"`
+	State            string  `json:"state"`
+	Type             string  `json:"type"`
+	UpdatedTimestamp int64   `json:"updated_timestamp"`
+}
+
+type SubmitTransferToSubaccountParams struct {
+	Currency    string  `json:"currency" validate:"required"`
+	Amount      float64 `json:"amount" validate:"required,gt=0"`
+	Destination int64   `json:"destination" validate:"required"`
+}
+
+type SubmitTransferToUserParams struct {
+	Currency string  `json:"currency" validate:"required"`
+	Amount   float64 `json:"amount" validate:"required,gt=0"`
+	// Destination is the wallet address of the receiving user
+	Destination string `json:"destination" validate:"required"`
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wallet
+
+type GetWithdrawalsParams struct {
+	Currency string `json:"currency" validate:"required"`
+	Count    int    `json:"count,omitempty" validate:"omitempty,min=1,max=1000"`
+	Offset   int    `json:"offset,omitempty"`
+}
+
+type GetWithdrawalsResponse struct {
+	Count int           `json:"count"`
+	Data  []*Withdrawal `json:"data"`
+}
+
+type Withdrawal struct {
+	Address            string  `json:"address"`
+	Amount             float64 `json:"amount"`
+	ConfirmedTimestamp int64   `json:"confirmed_timestamp"`
+	CreatedTimestamp   int64   `json:"created_timestamp"`
+	Currency           string  `json:"currency"`
+	Fee                float64 `json:"fee"`
+	ID                 int64   `json:"id"`
+	Priority           float64 `json:"priority"`
+	State              string  `json:"state"`
+	TransactionID      string  `json:"transaction_id"`
+	UpdatedTimestamp   int64   `json:"updated_timestamp"`
+}
+
+// WithdrawParams, the address has to be in the address book of the account
+type WithdrawParams struct {
+	Currency string  `json:"currency" validate:"required"`
+	Address  string  `json:"address" validate:"required"`
+	Amount   float64 `json:"amount" validate:"required,gt=0"`
+	Priority string  `json:"priority,omitempty" validate:"omitempty,oneof=insane extreme_high very_high high mid low very_low"`
+}
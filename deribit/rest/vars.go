@@ -22,6 +22,19 @@ const (
 	BaseURL        = "https://www.deribit.com"
 )
 
+const (
+	logPrefix = "deribit::rest"
+
+	// AuthTimeout is how long NewDeribitRestClient and the background refresh wait for public/auth, in seconds
+	AuthTimeout = 10
+
+	// RefreshBefore is how long before expiry the background refresh renews the token, in seconds
+	RefreshBefore = 60
+
+	// RefreshRetry is the min delay between two background renewals, in seconds
+	RefreshRetry = 5
+)
+
 var (
 	DefaultContentType = map[string]string{
 		"Content-Type": "application/json",
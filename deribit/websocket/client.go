@@ -0,0 +1,573 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	validator "github.com/go-playground/validator/v10"
+	"github.com/gorilla/websocket"
+	resttypes "github.com/linstohu/nexapi/deribit/rest/types"
+	"github.com/linstohu/nexapi/deribit/rest/types/auth"
+	"github.com/linstohu/nexapi/deribit/websocket/types"
+	"github.com/linstohu/nexapi/utils"
+	cmap "github.com/orcaman/concurrent-map/v2"
+)
+
+type DeribitWsClient struct {
+	baseURL     string
+	key, secret string
+	// debug mode
+	debug bool
+	// logger
+	logger *slog.Logger
+	// validate struct fields
+	validate *validator.Validate
+
+	stopCtx context.Context
+	cancel  context.CancelFunc
+
+	conn        *websocket.Conn
+	mu          sync.RWMutex
+	isConnected bool
+
+	autoReconnect bool
+
+	scope        string
+	useSignature bool
+
+	sending       sync.Mutex
+	subscriptions cmap.ConcurrentMap[string, struct{}]
+
+	// pending holds requests waiting for the response with the same id
+	reqID   atomic.Uint64
+	pending cmap.ConcurrentMap[string, chan *types.Message]
+
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
+}
+
+type DeribitWsClientCfg struct {
+	Debug bool
+	// WsURL or TestNetWsURL
+	BaseURL       string `validate:"required"`
+	AutoReconnect bool   `validate:"required"`
+
+	// Key and Secret authenticate the connection by public/auth, they are needed by user.* channels
+	Key    string
+	Secret string
+	// Scope requests a scoped token, e.g. "session:name trade:read_write"
+	Scope string
+	// UseSignature authenticates with the client_signature grant, the secret is never sent
+	UseSignature bool
+
+	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
+}
+
+func NewDeribitWsClient(cfg *DeribitWsClientCfg) (*DeribitWsClient, error) {
+	validator := validator.New()
+
+	if err := validator.Struct(cfg); err != nil {
+		return nil, err
+	}
+
+	cli := &DeribitWsClient{
+		baseURL: cfg.BaseURL,
+		key:     cfg.Key,
+		secret:  cfg.Secret,
+		debug:   cfg.Debug,
+		logger:  cfg.Logger,
+
+		validate: validator,
+
+		autoReconnect: cfg.AutoReconnect,
+
+		scope:        cfg.Scope,
+		useSignature: cfg.UseSignature,
+
+		subscriptions: cmap.New[struct{}](),
+		pending:       cmap.New[chan *types.Message](),
+		emitter:       utils.NewEmitter(),
+	}
+
+	if cli.logger == nil {
+		cli.logger = slog.Default()
+	}
+
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
+	return cli, nil
+}
+
+func (d *DeribitWsClient) Open() error {
+	if d.stopCtx != nil {
+		return fmt.Errorf("%s: ws is already open", logPrefix)
+	}
+
+	d.stopCtx, d.cancel = context.WithCancel(context.Background())
+
+	err := d.start()
+	if err != nil {
+		// do not keep reconnecting when the first connection fails
+		d.cancel()
+		return err
+	}
+
+	return nil
+}
+
+func (d *DeribitWsClient) Close() error {
+	if d.stopCtx == nil {
+		return fmt.Errorf("%s: ws is not open", logPrefix)
+	}
+
+	d.cancel()
+
+	if d.dispatcher != nil {
+		d.dispatcher.Close()
+	}
+
+	return nil
+}
+
+func (d *DeribitWsClient) start() error {
+	d.setIsConnected(false)
+
+	// per connection state is handed to the goroutines, so a reconnect does not race with them
+	var (
+		conn       *websocket.Conn
+		disconnect = make(chan struct{})
+	)
+
+	for i := 0; i < MaxTryTimes; i++ {
+		c, _, err := d.connect()
+		if err != nil {
+			d.logger.Info(fmt.Sprintf("%s: connect error, times(%v), error: %s", logPrefix, i, err.Error()))
+			tm := (i + 1) * 5
+			time.Sleep(time.Duration(tm) * time.Second)
+			continue
+		}
+		conn = c
+		break
+	}
+	if conn == nil {
+		return errors.New("connect failed")
+	}
+
+	d.logger.Info(fmt.Sprintf("%s: connect success, base_url: %s", logPrefix, d.baseURL))
+
+	d.sending.Lock()
+	d.conn = conn
+	d.sending.Unlock()
+
+	d.setIsConnected(true)
+
+	// responses are read by readMessages
+	go d.readMessages(conn, disconnect)
+
+	// watch for disconnect before auth, a failed auth closes the connection and is retried by reconnect
+	if d.autoReconnect {
+		go d.reconnect(disconnect)
+	}
+
+	ctx, cancel := context.WithTimeout(d.stopCtx, RequestTimeout*time.Second)
+	defer cancel()
+
+	if d.key != "" && d.secret != "" {
+		token, err := d.Auth(ctx)
+		if err != nil {
+			d.logger.Error(fmt.Sprintf("%s: auth error, %s", logPrefix, err.Error()))
+			// readMessages sees the closed connection and calls close
+			conn.Close()
+			return err
+		}
+
+		go d.refreshLoop(conn, disconnect, token)
+	}
+
+	if err := d.Call(ctx, "public/set_heartbeat", map[string]any{"interval": HeartbeatInterval}, nil); err != nil {
+		d.logger.Error(fmt.Sprintf("%s: set heartbeat error, %s", logPrefix, err.Error()))
+	}
+
+	if err := d.resubscribe(ctx); err != nil {
+		d.logger.Error(fmt.Sprintf("%s: resubscribe error, %s", logPrefix, err.Error()))
+	}
+
+	return nil
+}
+
+func (d *DeribitWsClient) connect() (*websocket.Conn, *http.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, d.baseURL, nil)
+	if err == nil {
+		conn.SetReadLimit(32768 * 64)
+	}
+
+	return conn, resp, err
+}
+
+func (d *DeribitWsClient) reconnect(disconnect chan struct{}) {
+	<-disconnect
+
+	d.setIsConnected(false)
+
+	time.Sleep(1 * time.Second)
+
+	select {
+	case <-d.stopCtx.Done():
+		d.logger.Info(fmt.Sprintf("%s: reconnection exits", logPrefix))
+		return
+	default:
+		d.logger.Info(fmt.Sprintf("%s: try to reconnect...", logPrefix))
+		d.start()
+	}
+}
+
+// close closes the websocket connection
+func (d *DeribitWsClient) close(conn *websocket.Conn, disconnect chan struct{}) error {
+	d.setIsConnected(false)
+	d.failPending()
+
+	close(disconnect)
+
+	err := conn.Close()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setIsConnected sets state for isConnected
+func (d *DeribitWsClient) setIsConnected(state bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.isConnected = state
+}
+
+// IsConnected returns the WebSocket connection state
+func (d *DeribitWsClient) IsConnected() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.isConnected
+}
+
+// Auth authenticates the connection with client_credentials, or client_signature when UseSignature is set,
+// it is called on every connect when key is set and the token is refreshed until the connection drops
+func (d *DeribitWsClient) Auth(ctx context.Context) (*auth.AuthResponse, error) {
+	param, err := auth.NewCredentials(d.key, d.secret, d.scope, d.useSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.auth(ctx, param)
+}
+
+func (d *DeribitWsClient) auth(ctx context.Context, param *auth.AuthParams) (*auth.AuthResponse, error) {
+	err := d.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret auth.AuthResponse
+	if err := d.Call(ctx, "public/auth", param, &ret); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+// refreshLoop renews the token of conn with refresh_token RefreshBefore seconds before it expires,
+// or halfway for short lived tokens, and falls back to the credentials.
+// conn is closed when both fail, so private subscriptions are restored by reconnect.
+func (d *DeribitWsClient) refreshLoop(conn *websocket.Conn, disconnect chan struct{}, token *auth.AuthResponse) {
+	for {
+		wait := time.Duration(token.ExpiresIn-RefreshBefore) * time.Second
+		if token.ExpiresIn < 2*RefreshBefore {
+			wait = time.Duration(token.ExpiresIn) * time.Second / 2
+		}
+		if wait < time.Second {
+			wait = time.Second
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-disconnect:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		ctx, cancel := context.WithTimeout(d.stopCtx, RequestTimeout*time.Second)
+		next, err := d.auth(ctx, auth.NewRefresh(token.RefreshToken))
+		if err != nil {
+			d.logger.Info(fmt.Sprintf("%s: refresh token failed, auth with credentials, error: %v", logPrefix, err))
+			next, err = d.Auth(ctx)
+		}
+		cancel()
+
+		if err != nil {
+			d.logger.Error(fmt.Sprintf("%s: token renewal failed, close the connection, error: %v", logPrefix, err))
+			conn.Close()
+			return
+		}
+
+		token = next
+	}
+}
+
+// ErrDisconnected is returned by Call when the connection drops before the response arrives,
+// a private method such as private/buy may still have been executed by deribit.
+var ErrDisconnected = errors.New("connection closed before the response arrived")
+
+// Call sends a JSON-RPC request and waits for the response with the same id, result is skipped when nil.
+// RequestTimeout is applied when ctx has no deadline.
+func (d *DeribitWsClient) Call(ctx context.Context, method string, params any, result any) error {
+	id := d.reqID.Add(1)
+	key := strconv.FormatUint(id, 10)
+
+	ch := make(chan *types.Message, 1)
+	d.pending.Set(key, ch)
+	defer d.pending.Remove(key)
+
+	err := d.send(&resttypes.Body{
+		Jsonrpc: JsonRPCVersion,
+		ID:      id,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, RequestTimeout*time.Second)
+		defer cancel()
+	}
+
+	select {
+	case msg := <-ch:
+		if msg == nil {
+			return fmt.Errorf("%s: method: %s, id: %s, %w", logPrefix, method, key, ErrDisconnected)
+		}
+		if msg.Error != nil {
+			return msg.Error
+		}
+		if result == nil {
+			return nil
+		}
+		return json.Unmarshal(msg.Result, result)
+	case <-ctx.Done():
+		return fmt.Errorf("%s: wait for response, method: %s, id: %s, %w", logPrefix, method, key, ctx.Err())
+	}
+}
+
+func (d *DeribitWsClient) readMessages(conn *websocket.Conn, disconnect chan struct{}) {
+	for {
+		select {
+		case <-d.stopCtx.Done():
+			d.logger.Info(fmt.Sprintf("%s: ready to close...", logPrefix))
+
+			if err := d.close(conn, disconnect); err != nil {
+				d.logger.Error(fmt.Sprintf("%s: connection closed error, %s", logPrefix, err.Error()))
+				return
+			}
+
+			d.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
+			return
+		default:
+			_, buf, err := conn.ReadMessage()
+			if err != nil {
+				d.logger.Info(fmt.Sprintf("%s: read message error, %s", logPrefix, err))
+				d.logger.Info(fmt.Sprintf("%s: ready to close...", logPrefix))
+
+				if err := d.close(conn, disconnect); err != nil {
+					d.logger.Error(fmt.Sprintf("%s: connection closed error, %s", logPrefix, err.Error()))
+					return
+				}
+
+				d.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
+				return
+			}
+
+			// ids are decoded as json.Number to match the pending key
+			var msg types.Message
+			dec := json.NewDecoder(bytes.NewReader(buf))
+			dec.UseNumber()
+			if err := dec.Decode(&msg); err != nil {
+				d.logger.Info(fmt.Sprintf("%s: read object error, %s", logPrefix, err))
+				continue
+			}
+
+			switch {
+			case msg.ID != nil:
+				d.deliver(&msg)
+			case msg.Method == "heartbeat":
+				if msg.Params != nil && msg.Params.Type == "test_request" {
+					go d.Call(d.stopCtx, "public/test", nil, nil)
+				}
+			case msg.Method == "subscription" && msg.Params != nil:
+				err := d.handle(msg.Params)
+				if err != nil {
+					d.logger.Info(fmt.Sprintf("%s: handle message error: %s", logPrefix, err.Error()))
+				}
+			}
+		}
+	}
+}
+
+func (d *DeribitWsClient) deliver(msg *types.Message) {
+	key := fmt.Sprint(msg.ID)
+
+	ch, ok := d.pending.Get(key)
+	if !ok {
+		if d.debug {
+			d.logger.Info(fmt.Sprintf("%s: no pending request, id: %s", logPrefix, key))
+		}
+		return
+	}
+
+	select {
+	case ch <- msg:
+	default:
+	}
+}
+
+// failPending wakes every waiting Call with ErrDisconnected,
+// deribit answers a request only on the connection it was sent on
+func (d *DeribitWsClient) failPending() {
+	for _, key := range d.pending.Keys() {
+		ch, ok := d.pending.Pop(key)
+		if !ok {
+			continue
+		}
+
+		// nil means disconnected, a response already buffered is kept
+		select {
+		case ch <- nil:
+		default:
+		}
+	}
+}
+
+func (d *DeribitWsClient) resubscribe(ctx context.Context) error {
+	channels := d.subscriptions.Keys()
+
+	if len(channels) == 0 {
+		return nil
+	}
+
+	return d.request(ctx, "subscribe", channels)
+}
+
+func (d *DeribitWsClient) subscribe(ctx context.Context, channels []string) error {
+	args := make([]string, 0, len(channels))
+
+	for _, channel := range channels {
+		if d.subscriptions.Has(channel) {
+			continue
+		}
+
+		args = append(args, channel)
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	err := d.request(ctx, "subscribe", args)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range args {
+		d.subscriptions.Set(v, struct{}{})
+	}
+
+	return nil
+}
+
+func (d *DeribitWsClient) unsubscribe(ctx context.Context, channels []string) error {
+	err := d.request(ctx, "unsubscribe", channels)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range channels {
+		d.subscriptions.Remove(v)
+	}
+
+	return nil
+}
+
+// request sends user.* channels by the private method and others by the public method
+func (d *DeribitWsClient) request(ctx context.Context, op string, channels []string) error {
+	var public, private []string
+	for _, v := range channels {
+		if strings.HasPrefix(v, "user.") {
+			private = append(private, v)
+		} else {
+			public = append(public, v)
+		}
+	}
+
+	for scope, list := range map[string][]string{"public": public, "private": private} {
+		if len(list) == 0 {
+			continue
+		}
+
+		err := d.Call(ctx, scope+"/"+op, map[string]any{"channels": list}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DeribitWsClient) send(req *resttypes.Body) error {
+	d.sending.Lock()
+	defer d.sending.Unlock()
+
+	if !d.IsConnected() {
+		return errors.New("connection is closed")
+	}
+
+	return d.conn.WriteJSON(req)
+}
@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	resttypes "github.com/linstohu/nexapi/deribit/rest/types"
+	"github.com/linstohu/nexapi/deribit/rest/types/auth"
+	"github.com/linstohu/nexapi/deribit/rest/types/marketdata"
+	"github.com/linstohu/nexapi/deribit/websocket/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNewDeribitWsClient(t *testing.T) *DeribitWsClient {
+	cli, err := NewDeribitWsClient(&DeribitWsClientCfg{
+		Debug:         true,
+		BaseURL:       TestNetWsURL,
+		AutoReconnect: true,
+		Key:           os.Getenv("DERIBIT_KEY"),
+		Secret:        os.Getenv("DERIBIT_SECRET"),
+	})
+
+	if err != nil {
+		t.Fatalf("Could not create websocket client, %s", err)
+	}
+
+	err = cli.Open()
+	if err != nil {
+		t.Fatalf("Could not open websocket client, %s", err)
+	}
+
+	return cli
+}
+
+func TestCall(t *testing.T) {
+	cli := testNewDeribitWsClient(t)
+	defer cli.Close()
+
+	var ret struct {
+		Version string `json:"version"`
+	}
+	err := cli.Call(context.TODO(), "public/test", nil, &ret)
+	assert.Nil(t, err)
+
+	fmt.Printf("Version: %s\n", ret.Version)
+}
+
+func TestSubscribeBook(t *testing.T) {
+	cli := testNewDeribitWsClient(t)
+	defer cli.Close()
+
+	channel, err := cli.GetBookChannel(&InstrumentChannelParam{
+		InstrumentName: "BTC-PERPETUAL",
+		Interval:       "100ms",
+	})
+	assert.Nil(t, err)
+
+	cli.OnBook(channel, func(e *types.Book) {
+		fmt.Printf("Channel: %s, Type: %s, Bids: %v, Asks: %v\n", channel, e.Type, len(e.Bids), len(e.Asks))
+	})
+
+	err = cli.Subscribe(context.TODO(), []string{channel})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+
+	err = cli.UnSubscribe(context.TODO(), []string{channel})
+	assert.Nil(t, err)
+}
+
+func TestSubscribeTicker(t *testing.T) {
+	cli := testNewDeribitWsClient(t)
+	defer cli.Close()
+
+	channel, err := cli.GetTickerChannel(&InstrumentChannelParam{
+		InstrumentName: "BTC-PERPETUAL",
+		Interval:       "100ms",
+	})
+	assert.Nil(t, err)
+
+	cli.OnTicker(channel, func(e *marketdata.TickerResponse) {
+		fmt.Printf("Channel: %s, LastPrice: %v, MarkPrice: %v\n", channel, e.LastPrice, e.MarkPrice)
+	})
+
+	err = cli.Subscribe(context.TODO(), []string{channel})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+}
+
+func TestSubscribePortfolio(t *testing.T) {
+	cli := testNewDeribitWsClient(t)
+	defer cli.Close()
+
+	channel, err := cli.GetUserPortfolioChannel("btc")
+	assert.Nil(t, err)
+
+	cli.OnPortfolio(channel, func(e *types.Portfolio) {
+		fmt.Printf("Channel: %s, Equity: %v, Balance: %v\n", channel, e.Equity, e.Balance)
+	})
+
+	err = cli.Subscribe(context.TODO(), []string{channel})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+}
+
+type testRequest struct {
+	ID     uint64          `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// testServe runs a local JSON-RPC websocket server, handle is called with the connection number and every request,
+// it returns the result or the error of the response, nothing is answered when both are nil,
+// and the connection is dropped when keep is false
+func testServe(t *testing.T, handle func(n int32, req *testRequest) (result any, rpcErr *resttypes.JsonError, keep bool)) (string, *atomic.Int32) {
+	var conns atomic.Int32
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		n := conns.Add(1)
+
+		for {
+			var req testRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+
+			result, rpcErr, keep := handle(n, &req)
+
+			if result != nil || rpcErr != nil {
+				resp := map[string]any{"jsonrpc": JsonRPCVersion, "id": req.ID}
+				if rpcErr != nil {
+					resp["error"] = rpcErr
+				} else {
+					resp["result"] = result
+				}
+				conn.WriteJSON(resp)
+			}
+
+			if !keep {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return "ws" + strings.TrimPrefix(srv.URL, "http"), &conns
+}
+
+func TestReconnectAfterAuthFailure(t *testing.T) {
+	url, conns := testServe(t, func(n int32, req *testRequest) (any, *resttypes.JsonError, bool) {
+		if req.Method != "public/auth" {
+			return "ok", nil, true
+		}
+
+		switch n {
+		case 1:
+			// auth, then drop the connection
+			return auth.AuthResponse{AccessToken: "token", ExpiresIn: 900}, nil, false
+		case 2:
+			return nil, &resttypes.JsonError{Code: 13004, Message: "invalid_credentials"}, true
+		default:
+			return auth.AuthResponse{AccessToken: "token", ExpiresIn: 900}, nil, true
+		}
+	})
+
+	cli, err := NewDeribitWsClient(&DeribitWsClientCfg{
+		BaseURL:       url,
+		AutoReconnect: true,
+		Key:           "key",
+		Secret:        "secret",
+	})
+	assert.Nil(t, err)
+
+	err = cli.Open()
+	assert.Nil(t, err)
+	defer cli.Close()
+
+	assert.Eventually(t, func() bool {
+		return conns.Load() == 3 && cli.IsConnected()
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestAuthSignatureAndRefresh(t *testing.T) {
+	var (
+		grants = make(chan auth.AuthParams, 4)
+		issued atomic.Int32
+	)
+
+	url, _ := testServe(t, func(n int32, req *testRequest) (any, *resttypes.JsonError, bool) {
+		if req.Method != "public/auth" {
+			return "ok", nil, true
+		}
+
+		var param auth.AuthParams
+		json.Unmarshal(req.Params, &param)
+		grants <- param
+
+		// expires quickly, so the token is refreshed halfway
+		return auth.AuthResponse{AccessToken: "token", RefreshToken: fmt.Sprintf("refresh-%d", issued.Add(1)), ExpiresIn: 2}, nil, true
+	})
+
+	cli, err := NewDeribitWsClient(&DeribitWsClientCfg{
+		BaseURL:       url,
+		AutoReconnect: true,
+		Key:           "key",
+		Secret:        "secret",
+		Scope:         "session:test",
+		UseSignature:  true,
+	})
+	assert.Nil(t, err)
+
+	err = cli.Open()
+	assert.Nil(t, err)
+	defer cli.Close()
+
+	first := <-grants
+	assert.Equal(t, "client_signature", first.GrantType)
+	assert.Equal(t, "session:test", first.Scope)
+	assert.NotEmpty(t, first.Signature)
+	assert.Empty(t, first.ClientSecret)
+
+	select {
+	case next := <-grants:
+		assert.Equal(t, "refresh_token", next.GrantType)
+		assert.Equal(t, "refresh-1", next.RefreshToken)
+	case <-time.After(5 * time.Second):
+		t.Fatal("token was not refreshed")
+	}
+}
+
+func TestCallFailsOnDisconnect(t *testing.T) {
+	url, _ := testServe(t, func(n int32, req *testRequest) (any, *resttypes.JsonError, bool) {
+		if req.Method != "private/buy" {
+			return "ok", nil, true
+		}
+
+		// drop the connection without answering the order
+		return nil, nil, false
+	})
+
+	cli, err := NewDeribitWsClient(&DeribitWsClientCfg{
+		BaseURL:       url,
+		AutoReconnect: true,
+	})
+	assert.Nil(t, err)
+
+	err = cli.Open()
+	assert.Nil(t, err)
+	defer cli.Close()
+
+	start := time.Now()
+	err = cli.Call(context.TODO(), "private/buy", map[string]any{"instrument_name": "BTC-PERPETUAL", "amount": 10}, nil)
+	assert.ErrorIs(t, err, ErrDisconnected)
+	assert.Less(t, time.Since(start), RequestTimeout*time.Second)
+	assert.Empty(t, cli.pending.Keys())
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"github.com/linstohu/nexapi/deribit/rest/types/marketdata"
+	"github.com/linstohu/nexapi/deribit/rest/types/trading"
+	"github.com/linstohu/nexapi/deribit/websocket/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type Listener = utils.Listener
+
+// AddListener registers listener for event, the returned func removes it.
+func (d *DeribitWsClient) AddListener(event string, listener Listener) func() {
+	return d.emitter.On(event, listener)
+}
+
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (d *DeribitWsClient) RemoveListener(event string, listener Listener) {
+	d.emitter.Off(event, listener)
+}
+
+func (d *DeribitWsClient) GetListeners(event string, argument any) {
+	if d.dispatcher != nil {
+		d.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	d.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (d *DeribitWsClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if d.dispatcher == nil {
+		return nil
+	}
+
+	return d.dispatcher.Metrics()
+}
+
+func (d *DeribitWsClient) OnBook(channel string, fn func(*types.Book)) func() {
+	return utils.On(d, channel, fn)
+}
+
+func (d *DeribitWsClient) OnTrade(channel string, fn func(*marketdata.Trade)) func() {
+	return utils.On(d, channel, fn)
+}
+
+func (d *DeribitWsClient) OnTicker(channel string, fn func(*marketdata.TickerResponse)) func() {
+	return utils.On(d, channel, fn)
+}
+
+func (d *DeribitWsClient) OnPriceIndex(channel string, fn func(*types.PriceIndex)) func() {
+	return utils.On(d, channel, fn)
+}
+
+func (d *DeribitWsClient) OnUserOrder(channel string, fn func(*trading.Order)) func() {
+	return utils.On(d, channel, fn)
+}
+
+func (d *DeribitWsClient) OnUserTrade(channel string, fn func(*trading.Trade)) func() {
+	return utils.On(d, channel, fn)
+}
+
+func (d *DeribitWsClient) OnPortfolio(channel string, fn func(*types.Portfolio)) func() {
+	return utils.On(d, channel, fn)
+}
+
+func (d *DeribitWsClient) OnChanges(channel string, fn func(*types.Changes)) func() {
+	return utils.On(d, channel, fn)
+}
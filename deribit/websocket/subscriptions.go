@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/linstohu/nexapi/deribit/rest/types/marketdata"
+	"github.com/linstohu/nexapi/deribit/rest/types/trading"
+	"github.com/linstohu/nexapi/deribit/websocket/types"
+)
+
+func (d *DeribitWsClient) Subscribe(ctx context.Context, channels []string) error {
+	return d.subscribe(ctx, channels)
+}
+
+func (d *DeribitWsClient) UnSubscribe(ctx context.Context, channels []string) error {
+	return d.unsubscribe(ctx, channels)
+}
+
+func (d *DeribitWsClient) handle(msg *types.Notification) error {
+	channel := msg.Channel
+
+	if d.debug {
+		d.logger.Info(fmt.Sprintf("%s: subscribed message, channel: %s", logPrefix, channel))
+	}
+
+	switch {
+	case strings.HasPrefix(channel, "book."):
+		var data types.Book
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		d.GetListeners(channel, &data)
+	case strings.HasPrefix(channel, "trades."):
+		var data []*marketdata.Trade
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			d.GetListeners(channel, v)
+		}
+	case strings.HasPrefix(channel, "ticker."):
+		var data marketdata.TickerResponse
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		d.GetListeners(channel, &data)
+	case strings.HasPrefix(channel, "deribit_price_index."):
+		var data types.PriceIndex
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		d.GetListeners(channel, &data)
+	case strings.HasPrefix(channel, "user.orders."):
+		// raw channels push one order, others push a list
+		var data []*trading.Order
+		if len(msg.Data) > 0 && msg.Data[0] == '{' {
+			data = append(data, new(trading.Order))
+			err := json.Unmarshal(msg.Data, data[0])
+			if err != nil {
+				return err
+			}
+		} else {
+			err := json.Unmarshal(msg.Data, &data)
+			if err != nil {
+				return err
+			}
+		}
+		for _, v := range data {
+			d.GetListeners(channel, v)
+		}
+	case strings.HasPrefix(channel, "user.trades."):
+		var data []*trading.Trade
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			d.GetListeners(channel, v)
+		}
+	case strings.HasPrefix(channel, "user.portfolio."):
+		var data types.Portfolio
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		d.GetListeners(channel, &data)
+	case strings.HasPrefix(channel, "user.changes."):
+		var data types.Changes
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		d.GetListeners(channel, &data)
+	default:
+		return fmt.Errorf("unknown message, channel: %s", channel)
+	}
+
+	return nil
+}
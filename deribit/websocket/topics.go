@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+import (
+	"fmt"
+)
+
+type InstrumentChannelParam struct {
+	InstrumentName string `validate:"required"`
+	// raw needs an authorized connection
+	Interval string `validate:"required,oneof=raw 100ms agg2"`
+}
+
+type KindChannelParam struct {
+	Kind     string `validate:"required,oneof=future option spot future_combo option_combo any"`
+	Currency string `validate:"required"`
+	Interval string `validate:"required,oneof=raw 100ms agg2"`
+}
+
+func (d *DeribitWsClient) instrumentChannel(channel string, params *InstrumentChannelParam) (string, error) {
+	err := d.validate.Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s.%s.%s", channel, params.InstrumentName, params.Interval), nil
+}
+
+func (d *DeribitWsClient) kindChannel(channel string, params *KindChannelParam) (string, error) {
+	err := d.validate.Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s.%s.%s.%s", channel, params.Kind, params.Currency, params.Interval), nil
+}
+
+func (d *DeribitWsClient) GetBookChannel(params *InstrumentChannelParam) (string, error) {
+	return d.instrumentChannel("book", params)
+}
+
+type GroupedBookChannelParam struct {
+	InstrumentName string `validate:"required"`
+	// Group is none or the price grouping, e.g. 1, 2, 5, 10
+	Group    string `validate:"required"`
+	Depth    int    `validate:"required,oneof=1 10 20"`
+	Interval string `validate:"required,oneof=100ms agg2"`
+}
+
+// GetGroupedBookChannel pushes snapshots of a limited depth
+func (d *DeribitWsClient) GetGroupedBookChannel(params *GroupedBookChannelParam) (string, error) {
+	err := d.validate.Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("book.%s.%s.%d.%s", params.InstrumentName, params.Group, params.Depth, params.Interval), nil
+}
+
+func (d *DeribitWsClient) GetTradesChannel(params *InstrumentChannelParam) (string, error) {
+	return d.instrumentChannel("trades", params)
+}
+
+func (d *DeribitWsClient) GetTradesByKindChannel(params *KindChannelParam) (string, error) {
+	return d.kindChannel("trades", params)
+}
+
+func (d *DeribitWsClient) GetTickerChannel(params *InstrumentChannelParam) (string, error) {
+	return d.instrumentChannel("ticker", params)
+}
+
+// GetPriceIndexChannel takes an index name, e.g. btc_usd
+func (d *DeribitWsClient) GetPriceIndexChannel(indexName string) (string, error) {
+	if indexName == "" {
+		return "", fmt.Errorf("the indexName field must be provided")
+	}
+
+	return "deribit_price_index." + indexName, nil
+}
+
+func (d *DeribitWsClient) GetUserOrdersChannel(params *InstrumentChannelParam) (string, error) {
+	return d.instrumentChannel("user.orders", params)
+}
+
+func (d *DeribitWsClient) GetUserOrdersByKindChannel(params *KindChannelParam) (string, error) {
+	return d.kindChannel("user.orders", params)
+}
+
+func (d *DeribitWsClient) GetUserTradesChannel(params *InstrumentChannelParam) (string, error) {
+	return d.instrumentChannel("user.trades", params)
+}
+
+func (d *DeribitWsClient) GetUserTradesByKindChannel(params *KindChannelParam) (string, error) {
+	return d.kindChannel("user.trades", params)
+}
+
+func (d *DeribitWsClient) GetUserPortfolioChannel(currency string) (string, error) {
+	if currency == "" {
+		return "", fmt.Errorf("the currency field must be provided")
+	}
+
+	return "user.portfolio." + currency, nil
+}
+
+func (d *DeribitWsClient) GetUserChangesChannel(params *InstrumentChannelParam) (string, error) {
+	return d.instrumentChannel("user.changes", params)
+}
+
+func (d *DeribitWsClient) GetUserChangesByKindChannel(params *KindChannelParam) (string, error) {
+	return d.kindChannel("user.changes", params)
+}
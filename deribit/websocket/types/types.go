@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/linstohu/nexapi/deribit/rest/types"
+	"github.com/linstohu/nexapi/deribit/rest/types/account"
+	"github.com/linstohu/nexapi/deribit/rest/types/trading"
+)
+
+// Message is either a response, which carries ID, or a notification, which carries Method and Params
+type Message struct {
+	types.JsonrpcMessage
+	Method string        `json:"method,omitempty"`
+	Params *Notification `json:"params,omitempty"`
+}
+
+// Notification is the params of the subscription and heartbeat methods
+type Notification struct {
+	Channel string          `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+	// Type is heartbeat or test_request for the heartbeat method
+	Type string `json:"type"`
+}
+
+// Book is pushed by book.* channels, the first notification of raw and 100ms books is a snapshot,
+// then changes which must have PrevChangeId equal to the last ChangeId
+type Book struct {
+	Type           string      `json:"type"`
+	Timestamp      int64       `json:"timestamp"`
+	InstrumentName string      `json:"instrument_name"`
+	ChangeId       int64       `json:"change_id"`
+	PrevChangeId   int64       `json:"prev_change_id"`
+	Bids           []BookLevel `json:"bids"`
+	Asks           []BookLevel `json:"asks"`
+}
+
+// BookLevel is decoded from [action, price, amount] of raw and 100ms books, or [price, amount] of grouped books.
+// Action is one of new, change and delete, it is empty for grouped books.
+type BookLevel struct {
+	Action string
+	Price  float64
+	Amount float64
+}
+
+func (b *BookLevel) UnmarshalJSON(data []byte) error {
+	var arr []json.RawMessage
+	if err := json.Unmarshal(data, &arr); err != nil {
+		return err
+	}
+
+	switch len(arr) {
+	case 3:
+		if err := json.Unmarshal(arr[0], &b.Action); err != nil {
+			return err
+		}
+		arr = arr[1:]
+	case 2:
+	default:
+		return fmt.Errorf("invalid book level: %s", string(data))
+	}
+
+	if err := json.Unmarshal(arr[0], &b.Price); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(arr[1], &b.Amount)
+}
+
+type PriceIndex struct {
+	IndexName string  `json:"index_name"`
+	Price     float64 `json:"price"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+type Portfolio struct {
+	AdditionalReserve          float64 `json:"additional_reserve"`
+	AvailableFunds             float64 `json:"available_funds"`
+	AvailableWithdrawalFunds   float64 `json:"available_withdrawal_funds"`
+	Balance                    float64 `json:"balance"`
+	CrossCollateralEnabled     bool    `json:"cross_collateral_enabled"`
+	Currency                   string  `json:"currency"`
+	DeltaTotal                 float64 `json:"delta_total"`
+	Equity                     float64 `json:"equity"`
+	EstimatedLiquidationRatio  float64 `json:"estimated_liquidation_ratio"`
+	FeeBalance                 float64 `json:"fee_balance"`
+	FuturesPl                  float64 `json:"futures_pl"`
+	FuturesSessionRpl          float64 `json:"futures_session_rpl"`
+	FuturesSessionUpl          float64 `json:"futures_session_upl"`
+	InitialMargin              float64 `json:"initial_margin"`
+	MaintenanceMargin          float64 `json:"maintenance_margin"`
+	MarginBalance              float64 `json:"margin_balance"`
+	MarginModel                string  `json:"margin_model"`
+	OptionsDelta               float64 `json:"options_delta"`
+	OptionsGamma               float64 `json:"options_gamma"`
+	OptionsPl                  float64 `json:"options_pl"`
+	OptionsSessionRpl          float64 `json:"options_session_rpl"`
+	OptionsSessionUpl          float64 `json:"options_session_upl"`
+	OptionsTheta               float64 `json:"options_theta"`
+	OptionsValue               float64 `json:"options_value"`
+	OptionsVega                float64 `json:"options_vega"`
+	PortfolioMarginingEnabled  bool    `json:"portfolio_margining_enabled"`
+	ProjectedDeltaTotal        float64 `json:"projected_delta_total"`
+	ProjectedInitialMargin     float64 `json:"projected_initial_margin"`
+	ProjectedMaintenanceMargin float64 `json:"projected_maintenance_margin"`
+	SessionRpl                 float64 `json:"session_rpl"`
+	SessionUpl                 float64 `json:"session_upl"`
+	SpotReserve                float64 `json:"spot_reserve"`
+	TotalPl                    float64 `json:"total_pl"`
+}
+
+// Changes is pushed by user.changes.* channels when orders, trades or positions change
+type Changes struct {
+	InstrumentName string             `json:"instrument_name"`
+	Trades         []trading.Trade    `json:"trades"`
+	Positions      []account.Position `json:"positions"`
+	Orders         []trading.Order    `json:"orders"`
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websocket
+
+const (
+	WsURL        = "wss://www.deribit.com/ws/api/v2"
+	TestNetWsURL = "wss://test.deribit.com/ws/api/v2"
+
+	JsonRPCVersion = "2.0"
+)
+
+const (
+	logPrefix = "deribit::websocket"
+)
+
+const (
+	MaxTryTimes = 5
+
+	// HeartbeatInterval is passed to public/set_heartbeat, the server sends a test_request
+	// every interval and closes the connection when it is not answered, in seconds
+	HeartbeatInterval = 30
+
+	// RefreshBefore is how long before expiry the token of the connection is refreshed, in seconds
+	RefreshBefore = 60
+
+	// RequestTimeout is how long to wait for a response when ctx has no deadline, in seconds
+	RequestTimeout = 10
+)
@@ -3,7 +3,6 @@ module github.com/linstohu/nexapi
 go 1.21
 
 require (
-	github.com/chuckpreslar/emission v0.0.0-20170206194824-a7ddd980baf9
 	github.com/go-playground/validator v9.31.0+incompatible
 	github.com/go-playground/validator/v10 v10.13.0
 	github.com/google/go-querystring v1.1.0
@@ -31,9 +31,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/chuckpreslar/emission"
 	"github.com/go-playground/validator"
 	"github.com/gorilla/websocket"
+	"github.com/linstohu/nexapi/utils"
 	cmap "github.com/orcaman/concurrent-map/v2"
 )
 
@@ -60,7 +60,8 @@ type AccountWsClient struct {
 	sending       sync.Mutex
 	subscriptions cmap.ConcurrentMap[string, struct{}]
 
-	emitter *emission.Emitter
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
 }
 
 type AccountWsClientCfg struct {
@@ -72,6 +73,9 @@ type AccountWsClientCfg struct {
 	Secret string `validate:"required"`
 
 	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
 }
 
 func NewAccountWsClient(cfg *AccountWsClientCfg) (*AccountWsClient, error) {
@@ -96,13 +100,17 @@ func NewAccountWsClient(cfg *AccountWsClientCfg) (*AccountWsClient, error) {
 		autoReconnect: cfg.AutoReconnect,
 
 		subscriptions: cmap.New[struct{}](),
-		emitter:       emission.NewEmitter(),
+		emitter:       utils.NewEmitter(),
 	}
 
 	if cli.logger == nil {
 		cli.logger = slog.Default()
 	}
 
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
 	time.Sleep(100 * time.Millisecond)
 
 	return cli, nil
@@ -130,6 +138,10 @@ func (m *AccountWsClient) Close() error {
 
 	m.cancel()
 
+	if m.dispatcher != nil {
+		m.dispatcher.Close()
+	}
+
 	return nil
 }
 
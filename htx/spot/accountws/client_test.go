@@ -64,3 +64,28 @@ func TestSubscribeAccountUpdate(t *testing.T) {
 
 	select {}
 }
+
+func TestSubscribeOrders(t *testing.T) {
+	cli := testNewAccountWsClient(t, GlobalWsBaseURL)
+
+	topic, err := cli.GetOrdersTopic(&OrdersTopicParam{
+		Symbol: "*",
+	})
+	assert.Nil(t, err)
+
+	cli.OnOrderCreation(topic, func(e *types.OrderCreation) {
+		fmt.Printf("Topic: %s, Symbol: %v, OrderId: %v, Price: %v, Size: %v\n", topic, e.Symbol, e.OrderID, e.OrderPrice, e.OrderSize)
+	})
+
+	cli.OnOrderTrade(topic, func(e *types.OrderTrade) {
+		fmt.Printf("Topic: %s, Symbol: %v, OrderId: %v, TradePrice: %v, TradeVolume: %v, Status: %v\n", topic, e.Symbol, e.OrderID, e.TradePrice, e.TradeVolume, e.OrderStatus)
+	})
+
+	cli.OnOrderCancellation(topic, func(e *types.OrderCancellation) {
+		fmt.Printf("Topic: %s, Symbol: %v, OrderId: %v, Status: %v\n", topic, e.Symbol, e.OrderID, e.OrderStatus)
+	})
+
+	cli.Subscribe(topic)
+
+	select {}
+}
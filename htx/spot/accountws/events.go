@@ -17,18 +17,65 @@
 
 package accountws
 
-import "github.com/chuckpreslar/emission"
+import (
+	"github.com/linstohu/nexapi/htx/spot/accountws/types"
+	"github.com/linstohu/nexapi/utils"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (m *AccountWsClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (m *AccountWsClient) AddListener(event string, listener Listener) func() {
 	return m.emitter.On(event, listener)
 }
 
-func (m *AccountWsClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return m.emitter.Off(m, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (m *AccountWsClient) RemoveListener(event string, listener Listener) {
+	m.emitter.Off(event, listener)
 }
 
-func (m *AccountWsClient) GetListeners(event string, argument any) *emission.Emitter {
-	return m.emitter.Emit(event, argument)
+func (m *AccountWsClient) GetListeners(event string, argument any) {
+	if m.dispatcher != nil {
+		m.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	m.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (m *AccountWsClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if m.dispatcher == nil {
+		return nil
+	}
+
+	return m.dispatcher.Metrics()
+}
+
+func (m *AccountWsClient) OnAccountUpdate(topic string, fn func(*types.Account)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnOrderCreation(topic string, fn func(*types.OrderCreation)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnOrderTrade(topic string, fn func(*types.OrderTrade)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnOrderCancellation(topic string, fn func(*types.OrderCancellation)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnOrderTrigger(topic string, fn func(*types.OrderTrigger)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnOrderDeletion(topic string, fn func(*types.OrderDeletion)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnTradeClearing(topic string, fn func(*types.TradeClearing)) func() {
+	return utils.On(m, topic, fn)
 }
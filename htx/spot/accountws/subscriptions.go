@@ -46,9 +46,61 @@ func (m *AccountWsClient) handle(msg *Message) error {
 			return err
 		}
 		m.GetListeners(msg.Channel, &data)
+	case strings.HasPrefix(msg.Channel, "orders#"):
+		data, err := decodeOrderEvent(msg.Data)
+		if err != nil {
+			return err
+		}
+		m.emitWithWildcard(msg.Channel, data)
+	case strings.HasPrefix(msg.Channel, "trade.clearing#"):
+		var data types.TradeClearing
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		m.emitWithWildcard(msg.Channel, &data)
 	default:
 		return fmt.Errorf("unknown message, topic: %s", msg.Channel)
 	}
 
 	return nil
 }
+
+// emitWithWildcard also delivers to the * topic of the channel when it is subscribed
+func (m *AccountWsClient) emitWithWildcard(channel string, data any) {
+	m.GetListeners(channel, data)
+
+	wildcard := wildcardTopic(channel)
+	if wildcard != channel && m.subscriptions.Has(wildcard) {
+		m.GetListeners(wildcard, data)
+	}
+}
+
+func decodeOrderEvent(raw []byte) (any, error) {
+	var event types.OrderEvent
+	if err := json.Unmarshal(raw, &event); err != nil {
+		return nil, err
+	}
+
+	var data any
+	switch event.EventType {
+	case "creation":
+		data = &types.OrderCreation{}
+	case "trade":
+		data = &types.OrderTrade{}
+	case "cancellation":
+		data = &types.OrderCancellation{}
+	case "trigger":
+		data = &types.OrderTrigger{}
+	case "deletion":
+		data = &types.OrderDeletion{}
+	default:
+		return nil, fmt.Errorf("unknown order event type: %s", event.EventType)
+	}
+
+	if err := json.Unmarshal(raw, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -19,6 +19,7 @@ package accountws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator"
 )
@@ -35,3 +36,45 @@ func (m *AccountWsClient) GetAccountUpdateTopic(params *AccountUpdateTopicParam)
 
 	return fmt.Sprintf("accounts.update#%d", params.Mode), nil
 }
+
+type OrdersTopicParam struct {
+	// Symbol, e.g. btcusdt, * subscribes all symbols
+	Symbol string `validate:"required"`
+}
+
+func (m *AccountWsClient) GetOrdersTopic(params *OrdersTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("orders#%s", params.Symbol), nil
+}
+
+type TradeClearingTopicParam struct {
+	// Symbol, e.g. btcusdt, * subscribes all symbols
+	Symbol string `validate:"required"`
+	// Mode 0 pushes trade events only, mode 1 pushes trade and cancellation events
+	Mode int `validate:"oneof=0 1"`
+}
+
+func (m *AccountWsClient) GetTradeClearingTopic(params *TradeClearingTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("trade.clearing#%s#%d", params.Symbol, params.Mode), nil
+}
+
+// wildcardTopic replaces the symbol of a orders# or trade.clearing# channel with *
+func wildcardTopic(channel string) string {
+	parts := strings.Split(channel, "#")
+	if len(parts) < 2 {
+		return channel
+	}
+
+	parts[1] = "*"
+
+	return strings.Join(parts, "#")
+}
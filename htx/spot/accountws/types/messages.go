@@ -27,3 +27,114 @@ type Account struct {
 	ChangeTime  int64  `json:"changeTime,omitempty"`
 	SeqNum      int64  `json:"seqNum,omitempty"`
 }
+
+// OrderEvent is decoded first to pick the typed event of an orders#${symbol} push
+type OrderEvent struct {
+	EventType string `json:"eventType,omitempty"`
+}
+
+type OrderCreation struct {
+	EventType       string `json:"eventType,omitempty"`
+	Symbol          string `json:"symbol,omitempty"`
+	AccountID       int64  `json:"accountId,omitempty"`
+	OrderID         int64  `json:"orderId,omitempty"`
+	ClientOrderID   string `json:"clientOrderId,omitempty"`
+	OrderSource     string `json:"orderSource,omitempty"`
+	OrderPrice      string `json:"orderPrice,omitempty"`
+	OrderSize       string `json:"orderSize,omitempty"`
+	OrderValue      string `json:"orderValue,omitempty"`
+	Type            string `json:"type,omitempty"`
+	OrderStatus     string `json:"orderStatus,omitempty"`
+	OrderCreateTime int64  `json:"orderCreateTime,omitempty"`
+}
+
+type OrderTrade struct {
+	EventType       string `json:"eventType,omitempty"`
+	Symbol          string `json:"symbol,omitempty"`
+	TradePrice      string `json:"tradePrice,omitempty"`
+	TradeVolume     string `json:"tradeVolume,omitempty"`
+	OrderID         int64  `json:"orderId,omitempty"`
+	Type            string `json:"type,omitempty"`
+	ClientOrderID   string `json:"clientOrderId,omitempty"`
+	OrderSource     string `json:"orderSource,omitempty"`
+	OrderPrice      string `json:"orderPrice,omitempty"`
+	OrderSize       string `json:"orderSize,omitempty"`
+	OrderValue      string `json:"orderValue,omitempty"`
+	TradeID         int64  `json:"tradeId,omitempty"`
+	TradeTime       int64  `json:"tradeTime,omitempty"`
+	Aggressor       bool   `json:"aggressor,omitempty"`
+	OrderStatus     string `json:"orderStatus,omitempty"`
+	RemainAmt       string `json:"remainAmt,omitempty"`
+	ExecAmt         string `json:"execAmt,omitempty"`
+	OrderCreateTime int64  `json:"orderCreateTime,omitempty"`
+}
+
+type OrderCancellation struct {
+	EventType     string `json:"eventType,omitempty"`
+	Symbol        string `json:"symbol,omitempty"`
+	OrderID       int64  `json:"orderId,omitempty"`
+	Type          string `json:"type,omitempty"`
+	ClientOrderID string `json:"clientOrderId,omitempty"`
+	OrderSource   string `json:"orderSource,omitempty"`
+	OrderPrice    string `json:"orderPrice,omitempty"`
+	OrderSize     string `json:"orderSize,omitempty"`
+	OrderValue    string `json:"orderValue,omitempty"`
+	OrderStatus   string `json:"orderStatus,omitempty"`
+	RemainAmt     string `json:"remainAmt,omitempty"`
+	ExecAmt       string `json:"execAmt,omitempty"`
+	LastActTime   int64  `json:"lastActTime,omitempty"`
+}
+
+// OrderTrigger is pushed when a conditional order is triggered,
+// ErrCode and ErrMessage are set when the triggered order is rejected
+type OrderTrigger struct {
+	EventType     string `json:"eventType,omitempty"`
+	Symbol        string `json:"symbol,omitempty"`
+	OrderID       int64  `json:"orderId,omitempty"`
+	ClientOrderID string `json:"clientOrderId,omitempty"`
+	OrderSide     string `json:"orderSide,omitempty"`
+	OrderStatus   string `json:"orderStatus,omitempty"`
+	ErrCode       int    `json:"errCode,omitempty"`
+	ErrMessage    string `json:"errMessage,omitempty"`
+	LastActTime   int64  `json:"lastActTime,omitempty"`
+}
+
+// OrderDeletion is pushed when a conditional order is cancelled before it is triggered
+type OrderDeletion struct {
+	EventType     string `json:"eventType,omitempty"`
+	Symbol        string `json:"symbol,omitempty"`
+	ClientOrderID string `json:"clientOrderId,omitempty"`
+	OrderSide     string `json:"orderSide,omitempty"`
+	OrderStatus   string `json:"orderStatus,omitempty"`
+	LastActTime   int64  `json:"lastActTime,omitempty"`
+}
+
+// TradeClearing is pushed on trade.clearing#${symbol}#${mode},
+// cancellation events are only pushed in mode 1 and carry no trade fields
+type TradeClearing struct {
+	EventType       string `json:"eventType,omitempty"`
+	Symbol          string `json:"symbol,omitempty"`
+	OrderID         int64  `json:"orderId,omitempty"`
+	TradePrice      string `json:"tradePrice,omitempty"`
+	TradeVolume     string `json:"tradeVolume,omitempty"`
+	OrderSide       string `json:"orderSide,omitempty"`
+	OrderType       string `json:"orderType,omitempty"`
+	Aggressor       bool   `json:"aggressor,omitempty"`
+	TradeID         int64  `json:"tradeId,omitempty"`
+	TradeTime       int64  `json:"tradeTime,omitempty"`
+	TransactFee     string `json:"transactFee,omitempty"`
+	FeeCurrency     string `json:"feeCurrency,omitempty"`
+	FeeDeduct       string `json:"feeDeduct,omitempty"`
+	FeeDeductType   string `json:"feeDeductType,omitempty"`
+	AccountID       int64  `json:"accountId,omitempty"`
+	Source          string `json:"source,omitempty"`
+	OrderPrice      string `json:"orderPrice,omitempty"`
+	OrderSize       string `json:"orderSize,omitempty"`
+	OrderValue      string `json:"orderValue,omitempty"`
+	ClientOrderID   string `json:"clientOrderId,omitempty"`
+	StopPrice       string `json:"stopPrice,omitempty"`
+	Operator        string `json:"operator,omitempty"`
+	OrderCreateTime int64  `json:"orderCreateTime,omitempty"`
+	OrderStatus     string `json:"orderStatus,omitempty"`
+	RemainAmt       string `json:"remainAmt,omitempty"`
+}
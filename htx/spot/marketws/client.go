@@ -29,10 +29,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/chuckpreslar/emission"
 	"github.com/go-playground/validator"
 	"github.com/gorilla/websocket"
 	htxutils "github.com/linstohu/nexapi/htx/utils"
+	"github.com/linstohu/nexapi/utils"
 	cmap "github.com/orcaman/concurrent-map/v2"
 )
 
@@ -56,7 +56,8 @@ type MarketWsClient struct {
 	sending       sync.Mutex
 	subscriptions cmap.ConcurrentMap[string, struct{}]
 
-	emitter *emission.Emitter
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
 }
 
 type MarketWsClientCfg struct {
@@ -65,6 +66,9 @@ type MarketWsClientCfg struct {
 	AutoReconnect bool   `validate:"required"`
 
 	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
 }
 
 func NewMarketWsClient(cfg *MarketWsClientCfg) (*MarketWsClient, error) {
@@ -80,13 +84,17 @@ func NewMarketWsClient(cfg *MarketWsClientCfg) (*MarketWsClient, error) {
 		autoReconnect: cfg.AutoReconnect,
 
 		subscriptions: cmap.New[struct{}](),
-		emitter:       emission.NewEmitter(),
+		emitter:       utils.NewEmitter(),
 	}
 
 	if cli.logger == nil {
 		cli.logger = slog.Default()
 	}
 
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
 	return cli, nil
 }
 
@@ -112,6 +120,10 @@ func (m *MarketWsClient) Close() error {
 
 	m.cancel()
 
+	if m.dispatcher != nil {
+		m.dispatcher.Close()
+	}
+
 	return nil
 }
 
@@ -17,18 +17,61 @@
 
 package marketws
 
-import "github.com/chuckpreslar/emission"
+import (
+	"github.com/linstohu/nexapi/htx/spot/marketws/types"
+	"github.com/linstohu/nexapi/utils"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (m *MarketWsClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (m *MarketWsClient) AddListener(event string, listener Listener) func() {
 	return m.emitter.On(event, listener)
 }
 
-func (m *MarketWsClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return m.emitter.Off(m, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (m *MarketWsClient) RemoveListener(event string, listener Listener) {
+	m.emitter.Off(event, listener)
 }
 
-func (m *MarketWsClient) GetListeners(event string, argument any) *emission.Emitter {
-	return m.emitter.Emit(event, argument)
+func (m *MarketWsClient) GetListeners(event string, argument any) {
+	if m.dispatcher != nil {
+		m.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	m.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (m *MarketWsClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if m.dispatcher == nil {
+		return nil
+	}
+
+	return m.dispatcher.Metrics()
+}
+
+func (m *MarketWsClient) OnKline(topic string, fn func(*types.Kline)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *MarketWsClient) OnBBO(topic string, fn func(*types.BBO)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *MarketWsClient) OnDepth(topic string, fn func(*types.Depth)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *MarketWsClient) OnTicker(topic string, fn func(*types.Ticker)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *MarketWsClient) OnMarketTrade(topic string, fn func(*types.MarketTradeMsg)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *MarketWsClient) OnMBPRefreshDepth(topic string, fn func(*types.MBPRefreshDepth)) func() {
+	return utils.On(m, topic, fn)
 }
@@ -83,3 +83,34 @@ func TestSubscribeUnifyAccountUpdate(t *testing.T) {
 
 	select {}
 }
+
+func TestSubscribeCrossOrders(t *testing.T) {
+	cli := testNewAccountWsClient(t, GlobalOrderWsBaseURL)
+
+	topic, err := cli.GetCrossOrdersTopic("*")
+	assert.Nil(t, err)
+
+	cli.OnOrder(topic, func(e *types.Order) {
+		fmt.Printf("Topic: %s, ContractCode: %v, OrderId: %v, Status: %v, TradeVolume: %v\n",
+			topic, e.ContractCode, e.OrderIDStr, e.Status, e.TradeVolume)
+	})
+
+	cli.Subscribe(topic)
+
+	select {}
+}
+
+func TestSubscribeFundingRate(t *testing.T) {
+	cli := testNewAccountWsClient(t, GlobalOrderWsBaseURL)
+
+	topic, err := cli.GetFundingRateTopic("BTC-USDT")
+	assert.Nil(t, err)
+
+	cli.OnFundingRate(topic, func(e *types.FundingRates) {
+		fmt.Printf("Topic: %s, Data: %+v\n", topic, e.Data)
+	})
+
+	cli.Subscribe(topic)
+
+	select {}
+}
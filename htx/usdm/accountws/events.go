@@ -17,18 +17,77 @@
 
 package accountws
 
-import "github.com/chuckpreslar/emission"
+import (
+	"github.com/linstohu/nexapi/htx/usdm/accountws/types"
+	"github.com/linstohu/nexapi/utils"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (m *AccountWsClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (m *AccountWsClient) AddListener(event string, listener Listener) func() {
 	return m.emitter.On(event, listener)
 }
 
-func (m *AccountWsClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return m.emitter.Off(m, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (m *AccountWsClient) RemoveListener(event string, listener Listener) {
+	m.emitter.Off(event, listener)
 }
 
-func (m *AccountWsClient) GetListeners(event string, argument any) *emission.Emitter {
-	return m.emitter.Emit(event, argument)
+func (m *AccountWsClient) GetListeners(event string, argument any) {
+	if m.dispatcher != nil {
+		m.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	m.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (m *AccountWsClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if m.dispatcher == nil {
+		return nil
+	}
+
+	return m.dispatcher.Metrics()
+}
+
+func (m *AccountWsClient) OnIsolatedAccountUpdate(topic string, fn func(*types.IsoAccount)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnCrossAccountUpdate(topic string, fn func(*types.CrossAccount)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnUnifyAccountUpdate(topic string, fn func(*types.UnifyAccount)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnOrder(topic string, fn func(*types.Order)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnMatchOrder(topic string, fn func(*types.MatchOrder)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnPositions(topic string, fn func(*types.Positions)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnTriggerOrder(topic string, fn func(*types.TriggerOrder)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnLiquidationOrders(topic string, fn func(*types.LiquidationOrders)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnFundingRate(topic string, fn func(*types.FundingRates)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *AccountWsClient) OnContractInfo(topic string, fn func(*types.ContractInfos)) func() {
+	return utils.On(m, topic, fn)
 }
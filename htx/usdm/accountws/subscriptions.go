@@ -38,7 +38,58 @@ func (m *AccountWsClient) handle(msg *Message) error {
 		m.logger.Info(fmt.Sprintf("subscribed message, channel: %s", msg.Topic))
 	}
 
+	topic := strings.ToLower(msg.Topic)
+
 	switch {
+	case strings.HasPrefix(topic, "orders.") || strings.HasPrefix(topic, "orders_cross."):
+		var data types.Order
+		err := json.Unmarshal(msg.Raw, &data)
+		if err != nil {
+			return err
+		}
+		m.emit(msg.Topic, &data)
+	case strings.HasPrefix(topic, "matchorders.") || strings.HasPrefix(topic, "matchorders_cross."):
+		var data types.MatchOrder
+		err := json.Unmarshal(msg.Raw, &data)
+		if err != nil {
+			return err
+		}
+		m.emit(msg.Topic, &data)
+	case strings.HasPrefix(topic, "positions.") || strings.HasPrefix(topic, "positions_cross."):
+		var data types.Positions
+		err := json.Unmarshal(msg.Raw, &data)
+		if err != nil {
+			return err
+		}
+		m.emit(msg.Topic, &data)
+	case strings.HasPrefix(topic, "trigger_order.") || strings.HasPrefix(topic, "trigger_order_cross."):
+		var data types.TriggerOrder
+		err := json.Unmarshal(msg.Raw, &data)
+		if err != nil {
+			return err
+		}
+		m.emit(msg.Topic, &data)
+	case strings.HasPrefix(topic, "public.") && strings.HasSuffix(topic, ".liquidation_orders"):
+		var data types.LiquidationOrders
+		err := json.Unmarshal(msg.Raw, &data)
+		if err != nil {
+			return err
+		}
+		m.emit(msg.Topic, &data)
+	case strings.HasPrefix(topic, "public.") && strings.HasSuffix(topic, ".funding_rate"):
+		var data types.FundingRates
+		err := json.Unmarshal(msg.Raw, &data)
+		if err != nil {
+			return err
+		}
+		m.emit(msg.Topic, &data)
+	case strings.HasPrefix(topic, "public.") && strings.HasSuffix(topic, ".contract_info"):
+		var data types.ContractInfos
+		err := json.Unmarshal(msg.Raw, &data)
+		if err != nil {
+			return err
+		}
+		m.emit(msg.Topic, &data)
 	case strings.HasPrefix(msg.Topic, "accounts."):
 		var data types.IsoAccount
 		err := json.Unmarshal(msg.Raw, &data)
@@ -71,3 +122,20 @@ func (m *AccountWsClient) handle(msg *Message) error {
 
 	return nil
 }
+
+// emit delivers to every subscribed topic matching the pushed one,
+// so listeners can use the topic as it was subscribed, in any case or with *
+func (m *AccountWsClient) emit(pushed string, data any) {
+	matched := false
+
+	for _, v := range m.subscriptions.Keys() {
+		if topicMatches(v, pushed) {
+			m.GetListeners(v, data)
+			matched = true
+		}
+	}
+
+	if !matched {
+		m.GetListeners(pushed, data)
+	}
+}
@@ -19,6 +19,7 @@ package accountws
 
 import (
 	"fmt"
+	"strings"
 )
 
 func (m *AccountWsClient) GetIsolatedAccountUpdateTopic(contractCode string) (string, error) {
@@ -40,3 +41,74 @@ func (m *AccountWsClient) GetCrossAccountUpdateTopic(marginAccount string) (stri
 func (m *AccountWsClient) GetUnifyAccountUpdateTopic() (string, error) {
 	return "accounts_unify.USDT", nil
 }
+
+// GetIsolatedOrdersTopic, contractCode can be * for all contracts
+func (m *AccountWsClient) GetIsolatedOrdersTopic(contractCode string) (string, error) {
+	return contractTopic("orders.%s", contractCode)
+}
+
+func (m *AccountWsClient) GetCrossOrdersTopic(contractCode string) (string, error) {
+	return contractTopic("orders_cross.%s", contractCode)
+}
+
+func (m *AccountWsClient) GetIsolatedPositionsTopic(contractCode string) (string, error) {
+	return contractTopic("positions.%s", contractCode)
+}
+
+func (m *AccountWsClient) GetCrossPositionsTopic(contractCode string) (string, error) {
+	return contractTopic("positions_cross.%s", contractCode)
+}
+
+func (m *AccountWsClient) GetIsolatedMatchOrdersTopic(contractCode string) (string, error) {
+	return contractTopic("matchOrders.%s", contractCode)
+}
+
+func (m *AccountWsClient) GetCrossMatchOrdersTopic(contractCode string) (string, error) {
+	return contractTopic("matchOrders_cross.%s", contractCode)
+}
+
+func (m *AccountWsClient) GetIsolatedTriggerOrderTopic(contractCode string) (string, error) {
+	return contractTopic("trigger_order.%s", contractCode)
+}
+
+func (m *AccountWsClient) GetCrossTriggerOrderTopic(contractCode string) (string, error) {
+	return contractTopic("trigger_order_cross.%s", contractCode)
+}
+
+func (m *AccountWsClient) GetLiquidationOrdersTopic(contractCode string) (string, error) {
+	return contractTopic("public.%s.liquidation_orders", contractCode)
+}
+
+func (m *AccountWsClient) GetFundingRateTopic(contractCode string) (string, error) {
+	return contractTopic("public.%s.funding_rate", contractCode)
+}
+
+func (m *AccountWsClient) GetContractInfoTopic(contractCode string) (string, error) {
+	return contractTopic("public.%s.contract_info", contractCode)
+}
+
+func contractTopic(format, contractCode string) (string, error) {
+	if contractCode == "" {
+		return "", fmt.Errorf("the contract_code field must be provided")
+	}
+
+	return fmt.Sprintf(format, contractCode), nil
+}
+
+// topicMatches reports whether a pushed topic belongs to a subscribed one,
+// pushed topics are lower case and * matches any contract code
+func topicMatches(subscribed, pushed string) bool {
+	sub := strings.Split(subscribed, ".")
+	push := strings.Split(pushed, ".")
+	if len(sub) != len(push) {
+		return false
+	}
+
+	for i := range sub {
+		if sub[i] != "*" && !strings.EqualFold(sub[i], push[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+// Order is pushed on orders.$contract_code and orders_cross.$contract_code
+type Order struct {
+	Op              string       `json:"op,omitempty"`
+	Topic           string       `json:"topic,omitempty"`
+	Ts              int64        `json:"ts,omitempty"`
+	UID             string       `json:"uid,omitempty"`
+	Symbol          string       `json:"symbol,omitempty"`
+	ContractCode    string       `json:"contract_code,omitempty"`
+	Volume          float64      `json:"volume,omitempty"`
+	Price           float64      `json:"price,omitempty"`
+	OrderPriceType  string       `json:"order_price_type,omitempty"`
+	Direction       string       `json:"direction,omitempty"`
+	Offset          string       `json:"offset,omitempty"`
+	Status          int          `json:"status,omitempty"`
+	LeverRate       int          `json:"lever_rate,omitempty"`
+	OrderID         int64        `json:"order_id,omitempty"`
+	OrderIDStr      string       `json:"order_id_str,omitempty"`
+	ClientOrderID   int64        `json:"client_order_id,omitempty"`
+	OrderSource     string       `json:"order_source,omitempty"`
+	OrderType       int          `json:"order_type,omitempty"`
+	CreatedAt       int64        `json:"created_at,omitempty"`
+	CanceledAt      int64        `json:"canceled_at,omitempty"`
+	TradeVolume     float64      `json:"trade_volume,omitempty"`
+	TradeTurnover   float64      `json:"trade_turnover,omitempty"`
+	Fee             float64      `json:"fee,omitempty"`
+	FeeAsset        string       `json:"fee_asset,omitempty"`
+	TradeAvgPrice   float64      `json:"trade_avg_price,omitempty"`
+	MarginFrozen    float64      `json:"margin_frozen,omitempty"`
+	MarginAsset     string       `json:"margin_asset,omitempty"`
+	MarginMode      string       `json:"margin_mode,omitempty"`
+	MarginAccount   string       `json:"margin_account,omitempty"`
+	Profit          float64      `json:"profit,omitempty"`
+	RealProfit      float64      `json:"real_profit,omitempty"`
+	LiquidationType string       `json:"liquidation_type,omitempty"`
+	IsTpsl          int          `json:"is_tpsl,omitempty"`
+	ReduceOnly      int          `json:"reduce_only,omitempty"`
+	ContractType    string       `json:"contract_type,omitempty"`
+	Pair            string       `json:"pair,omitempty"`
+	BusinessType    string       `json:"business_type,omitempty"`
+	Trade           []OrderTrade `json:"trade,omitempty"`
+}
+
+type OrderTrade struct {
+	ID            string  `json:"id,omitempty"`
+	TradeID       int64   `json:"trade_id,omitempty"`
+	TradeVolume   float64 `json:"trade_volume,omitempty"`
+	TradePrice    float64 `json:"trade_price,omitempty"`
+	TradeFee      float64 `json:"trade_fee,omitempty"`
+	FeeAsset      string  `json:"fee_asset,omitempty"`
+	TradeTurnover float64 `json:"trade_turnover,omitempty"`
+	CreatedAt     int64   `json:"created_at,omitempty"`
+	Role          string  `json:"role,omitempty"`
+	Profit        float64 `json:"profit,omitempty"`
+	RealProfit    float64 `json:"real_profit,omitempty"`
+}
+
+// MatchOrder is pushed on matchOrders.$contract_code and matchOrders_cross.$contract_code,
+// it comes ahead of Order and carries no fee or profit fields
+type MatchOrder struct {
+	Op             string            `json:"op,omitempty"`
+	Topic          string            `json:"topic,omitempty"`
+	Ts             int64             `json:"ts,omitempty"`
+	UID            string            `json:"uid,omitempty"`
+	Symbol         string            `json:"symbol,omitempty"`
+	ContractCode   string            `json:"contract_code,omitempty"`
+	Status         int               `json:"status,omitempty"`
+	OrderID        int64             `json:"order_id,omitempty"`
+	OrderIDStr     string            `json:"order_id_str,omitempty"`
+	ClientOrderID  int64             `json:"client_order_id,omitempty"`
+	OrderType      int               `json:"order_type,omitempty"`
+	OrderSource    string            `json:"order_source,omitempty"`
+	OrderPriceType string            `json:"order_price_type,omitempty"`
+	Volume         float64           `json:"volume,omitempty"`
+	TradeVolume    float64           `json:"trade_volume,omitempty"`
+	Price          float64           `json:"price,omitempty"`
+	Direction      string            `json:"direction,omitempty"`
+	Offset         string            `json:"offset,omitempty"`
+	LeverRate      int               `json:"lever_rate,omitempty"`
+	CreatedAt      int64             `json:"created_at,omitempty"`
+	MarginMode     string            `json:"margin_mode,omitempty"`
+	MarginAccount  string            `json:"margin_account,omitempty"`
+	IsTpsl         int               `json:"is_tpsl,omitempty"`
+	ReduceOnly     int               `json:"reduce_only,omitempty"`
+	ContractType   string            `json:"contract_type,omitempty"`
+	Pair           string            `json:"pair,omitempty"`
+	BusinessType   string            `json:"business_type,omitempty"`
+	Trade          []MatchOrderTrade `json:"trade,omitempty"`
+}
+
+type MatchOrderTrade struct {
+	ID            string  `json:"id,omitempty"`
+	TradeID       int64   `json:"trade_id,omitempty"`
+	TradeVolume   float64 `json:"trade_volume,omitempty"`
+	TradePrice    float64 `json:"trade_price,omitempty"`
+	TradeTurnover float64 `json:"trade_turnover,omitempty"`
+	CreatedAt     int64   `json:"created_at,omitempty"`
+	Role          string  `json:"role,omitempty"`
+}
+
+// TriggerOrder is pushed on trigger_order.$contract_code and trigger_order_cross.$contract_code
+type TriggerOrder struct {
+	Op    string             `json:"op,omitempty"`
+	Topic string             `json:"topic,omitempty"`
+	Ts    int64              `json:"ts,omitempty"`
+	Event string             `json:"event,omitempty"`
+	UID   string             `json:"uid,omitempty"`
+	Data  []TriggerOrderData `json:"data,omitempty"`
+}
+
+type TriggerOrderData struct {
+	Symbol          string  `json:"symbol,omitempty"`
+	ContractCode    string  `json:"contract_code,omitempty"`
+	TriggerType     string  `json:"trigger_type,omitempty"`
+	Volume          float64 `json:"volume,omitempty"`
+	OrderType       int     `json:"order_type,omitempty"`
+	Direction       string  `json:"direction,omitempty"`
+	Offset          string  `json:"offset,omitempty"`
+	LeverRate       int     `json:"lever_rate,omitempty"`
+	OrderID         int64   `json:"order_id,omitempty"`
+	OrderIDStr      string  `json:"order_id_str,omitempty"`
+	RelationOrderID string  `json:"relation_order_id,omitempty"`
+	OrderPriceType  string  `json:"order_price_type,omitempty"`
+	Status          int     `json:"status,omitempty"`
+	OrderSource     string  `json:"order_source,omitempty"`
+	TriggerPrice    float64 `json:"trigger_price,omitempty"`
+	TriggeredPrice  float64 `json:"triggered_price,omitempty"`
+	OrderPrice      float64 `json:"order_price,omitempty"`
+	CreatedAt       int64   `json:"created_at,omitempty"`
+	TriggeredAt     int64   `json:"triggered_at,omitempty"`
+	OrderInsertAt   int64   `json:"order_insert_at,omitempty"`
+	CanceledAt      int64   `json:"canceled_at,omitempty"`
+	FailCode        int     `json:"fail_code,omitempty"`
+	FailReason      string  `json:"fail_reason,omitempty"`
+	MarginMode      string  `json:"margin_mode,omitempty"`
+	MarginAccount   string  `json:"margin_account,omitempty"`
+	ReduceOnly      int     `json:"reduce_only,omitempty"`
+	ContractType    string  `json:"contract_type,omitempty"`
+	Pair            string  `json:"pair,omitempty"`
+	BusinessType    string  `json:"business_type,omitempty"`
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+// Positions is pushed on positions.$contract_code and positions_cross.$contract_code
+type Positions struct {
+	Op    string         `json:"op,omitempty"`
+	Topic string         `json:"topic,omitempty"`
+	Ts    int64          `json:"ts,omitempty"`
+	Event string         `json:"event,omitempty"`
+	UID   string         `json:"uid,omitempty"`
+	Data  []PositionData `json:"data,omitempty"`
+}
+
+type PositionData struct {
+	Symbol         string  `json:"symbol,omitempty"`
+	ContractCode   string  `json:"contract_code,omitempty"`
+	Volume         float64 `json:"volume,omitempty"`
+	Available      float64 `json:"available,omitempty"`
+	Frozen         float64 `json:"frozen,omitempty"`
+	CostOpen       float64 `json:"cost_open,omitempty"`
+	CostHold       float64 `json:"cost_hold,omitempty"`
+	ProfitUnreal   float64 `json:"profit_unreal,omitempty"`
+	ProfitRate     float64 `json:"profit_rate,omitempty"`
+	Profit         float64 `json:"profit,omitempty"`
+	PositionMargin float64 `json:"position_margin,omitempty"`
+	LeverRate      int     `json:"lever_rate,omitempty"`
+	Direction      string  `json:"direction,omitempty"`
+	LastPrice      float64 `json:"last_price,omitempty"`
+	MarginAsset    string  `json:"margin_asset,omitempty"`
+	MarginMode     string  `json:"margin_mode,omitempty"`
+	MarginAccount  string  `json:"margin_account,omitempty"`
+	PositionMode   string  `json:"position_mode,omitempty"`
+	AdlRiskPercent float64 `json:"adl_risk_percent,omitempty"`
+	ContractType   string  `json:"contract_type,omitempty"`
+	Pair           string  `json:"pair,omitempty"`
+	BusinessType   string  `json:"business_type,omitempty"`
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+// LiquidationOrders is pushed on public.$contract_code.liquidation_orders
+type LiquidationOrders struct {
+	Op    string             `json:"op,omitempty"`
+	Topic string             `json:"topic,omitempty"`
+	Ts    int64              `json:"ts,omitempty"`
+	Data  []LiquidationOrder `json:"data,omitempty"`
+}
+
+type LiquidationOrder struct {
+	Symbol        string  `json:"symbol,omitempty"`
+	ContractCode  string  `json:"contract_code,omitempty"`
+	Direction     string  `json:"direction,omitempty"`
+	Offset        string  `json:"offset,omitempty"`
+	Volume        float64 `json:"volume,omitempty"`
+	Amount        float64 `json:"amount,omitempty"`
+	TradeTurnover float64 `json:"trade_turnover,omitempty"`
+	Price         float64 `json:"price,omitempty"`
+	CreatedAt     int64   `json:"created_at,omitempty"`
+	Pair          string  `json:"pair,omitempty"`
+	BusinessType  string  `json:"business_type,omitempty"`
+}
+
+// FundingRates is pushed on public.$contract_code.funding_rate
+type FundingRates struct {
+	Op    string        `json:"op,omitempty"`
+	Topic string        `json:"topic,omitempty"`
+	Ts    int64         `json:"ts,omitempty"`
+	Data  []FundingRate `json:"data,omitempty"`
+}
+
+type FundingRate struct {
+	Symbol         string `json:"symbol,omitempty"`
+	ContractCode   string `json:"contract_code,omitempty"`
+	FeeAsset       string `json:"fee_asset,omitempty"`
+	FundingTime    string `json:"funding_time,omitempty"`
+	FundingRate    string `json:"funding_rate,omitempty"`
+	EstimatedRate  string `json:"estimated_rate,omitempty"`
+	SettlementTime string `json:"settlement_time,omitempty"`
+}
+
+// ContractInfos is pushed on public.$contract_code.contract_info
+type ContractInfos struct {
+	Op    string         `json:"op,omitempty"`
+	Topic string         `json:"topic,omitempty"`
+	Ts    int64          `json:"ts,omitempty"`
+	Event string         `json:"event,omitempty"`
+	Data  []ContractInfo `json:"data,omitempty"`
+}
+
+type ContractInfo struct {
+	Symbol            string  `json:"symbol,omitempty"`
+	ContractCode      string  `json:"contract_code,omitempty"`
+	ContractSize      float64 `json:"contract_size,omitempty"`
+	PriceTick         float64 `json:"price_tick,omitempty"`
+	SettlementDate    string  `json:"settlement_date,omitempty"`
+	CreateDate        string  `json:"create_date,omitempty"`
+	DeliveryTime      string  `json:"delivery_time,omitempty"`
+	ContractStatus    int     `json:"contract_status,omitempty"`
+	SupportMarginMode string  `json:"support_margin_mode,omitempty"`
+	ContractType      string  `json:"contract_type,omitempty"`
+	Pair              string  `json:"pair,omitempty"`
+	BusinessType      string  `json:"business_type,omitempty"`
+}
@@ -29,10 +29,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/chuckpreslar/emission"
 	"github.com/go-playground/validator"
 	"github.com/gorilla/websocket"
 	htxutils "github.com/linstohu/nexapi/htx/utils"
+	"github.com/linstohu/nexapi/utils"
 	cmap "github.com/orcaman/concurrent-map/v2"
 )
 
@@ -56,7 +56,11 @@ type MarketWsClient struct {
 	sending       sync.Mutex
 	subscriptions cmap.ConcurrentMap[string, struct{}]
 
-	emitter *emission.Emitter
+	// books is only touched by the read goroutine
+	books map[string]*orderBook
+
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
 }
 
 type MarketWsClientCfg struct {
@@ -65,6 +69,9 @@ type MarketWsClientCfg struct {
 	AutoReconnect bool   `validate:"required"`
 	// Logger
 	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
 }
 
 func NewMarketWsClient(cfg *MarketWsClientCfg) (*MarketWsClient, error) {
@@ -80,13 +87,18 @@ func NewMarketWsClient(cfg *MarketWsClientCfg) (*MarketWsClient, error) {
 		autoReconnect: cfg.AutoReconnect,
 
 		subscriptions: cmap.New[struct{}](),
-		emitter:       emission.NewEmitter(),
+		books:         make(map[string]*orderBook),
+		emitter:       utils.NewEmitter(),
 	}
 
 	if cli.logger == nil {
 		cli.logger = slog.Default()
 	}
 
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
 	return cli, nil
 }
 
@@ -112,6 +124,10 @@ func (m *MarketWsClient) Close() error {
 
 	m.cancel()
 
+	if m.dispatcher != nil {
+		m.dispatcher.Close()
+	}
+
 	return nil
 }
 
@@ -119,6 +135,7 @@ func (m *MarketWsClient) start() error {
 	m.conn = nil
 	m.setIsConnected(false)
 	m.disconnect = make(chan struct{})
+	m.books = make(map[string]*orderBook)
 
 	for i := 0; i < MaxTryTimes; i++ {
 		conn, _, err := m.connect()
@@ -293,8 +310,9 @@ func (m *MarketWsClient) resubscribe() error {
 	for _, v := range topics {
 		// do subscription
 		err := m.send(&Request{
-			ID:  fmt.Sprintf("%v", rand.Uint32()),
-			Sub: v,
+			ID:       fmt.Sprintf("%v", rand.Uint32()),
+			Sub:      v,
+			DataType: dataType(v),
 		})
 
 		if err != nil {
@@ -318,8 +336,9 @@ func (m *MarketWsClient) subscribe(topic string) error {
 	// do subscription
 
 	err := m.send(&Request{
-		ID:  fmt.Sprintf("%v", rand.Uint32()),
-		Sub: topic,
+		ID:       fmt.Sprintf("%v", rand.Uint32()),
+		Sub:      topic,
+		DataType: dataType(topic),
 	})
 
 	if err != nil {
@@ -333,8 +352,9 @@ func (m *MarketWsClient) subscribe(topic string) error {
 
 func (m *MarketWsClient) unsubscribe(topic string) error {
 	err := m.send(&Request{
-		ID:    fmt.Sprintf("%v", rand.Uint32()),
-		UnSub: topic,
+		ID:       fmt.Sprintf("%v", rand.Uint32()),
+		UnSub:    topic,
+		DataType: dataType(topic),
 	})
 
 	if err != nil {
@@ -19,8 +19,13 @@ package marketws
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/linstohu/nexapi/htx/usdm/marketws/types"
 	usdmtypes "github.com/linstohu/nexapi/htx/usdm/rest/types"
 	"github.com/stretchr/testify/assert"
@@ -28,8 +33,9 @@ import (
 
 func testNewMarketWsClient(t *testing.T, url string) *MarketWsClient {
 	cli, err := NewMarketWsClient(&MarketWsClientCfg{
-		BaseURL: url,
-		Debug:   true,
+		BaseURL:       url,
+		AutoReconnect: true,
+		Debug:         true,
 	})
 
 	if err != nil {
@@ -91,3 +97,169 @@ func TestSubscribeDepth(t *testing.T) {
 
 	select {}
 }
+
+func TestSubscribeIncrementalDepth(t *testing.T) {
+	cli := testNewMarketWsClient(t, GlobalMarketWsBaseURL)
+
+	topic, err := cli.GetIncrementalDepthTopic(&IncrementalDepthTopicParam{
+		ContractCode: "BTC-USDT",
+		Size:         20,
+	})
+	assert.Nil(t, err)
+
+	cli.OnOrderBook(topic, func(e *types.OrderBook) {
+		fmt.Printf("Topic: %s, Version: %v, Bids: %v, Asks: %v\n", topic, e.Version, len(e.Bids), len(e.Asks))
+	})
+
+	err = cli.Open()
+	assert.Nil(t, err)
+	defer cli.Close()
+
+	err = cli.Subscribe(topic)
+	assert.Nil(t, err)
+
+	select {}
+}
+
+func TestIncrementalDepthMerge(t *testing.T) {
+	requests := make(chan Request, 8)
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var req Request
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			requests <- req
+		}
+	}))
+	defer srv.Close()
+
+	cli := testNewMarketWsClient(t, "ws"+strings.TrimPrefix(srv.URL, "http"))
+
+	err := cli.Open()
+	assert.Nil(t, err)
+	defer cli.Close()
+
+	topic, err := cli.GetIncrementalDepthTopic(&IncrementalDepthTopicParam{
+		ContractCode: "BTC-USDT",
+		Size:         20,
+	})
+	assert.Nil(t, err)
+
+	var got *types.OrderBook
+	cli.OnOrderBook(topic, func(e *types.OrderBook) {
+		got = e
+	})
+
+	tests := []struct {
+		name string
+		data types.IncrementalDepth
+		bids [][]float64
+		asks [][]float64
+		err  bool
+		// resync is true when the book is dropped and unsub, sub are sent
+		resync bool
+	}{
+		{
+			name: "update before snapshot is ignored",
+			data: types.IncrementalDepth{Event: "update", Version: 1, Bids: [][]float64{{100, 1}}},
+		},
+		{
+			name: "snapshot",
+			data: types.IncrementalDepth{Event: "snapshot", Version: 10, Bids: [][]float64{{99, 2}, {100, 1}, {98.5, 3}}, Asks: [][]float64{{102, 1}, {101, 2}}},
+			bids: [][]float64{{100, 1}, {99, 2}, {98.5, 3}},
+			asks: [][]float64{{101, 2}, {102, 1}},
+		},
+		{
+			name: "contiguous update",
+			data: types.IncrementalDepth{Event: "update", Version: 11, Bids: [][]float64{{99, 5}, {99.5, 1}}, Asks: [][]float64{{103, 4}}},
+			bids: [][]float64{{100, 1}, {99.5, 1}, {99, 5}, {98.5, 3}},
+			asks: [][]float64{{101, 2}, {102, 1}, {103, 4}},
+		},
+		{
+			name: "zero amount deletes the level",
+			data: types.IncrementalDepth{Event: "update", Version: 12, Bids: [][]float64{{100, 0}}, Asks: [][]float64{{101, 0}}},
+			bids: [][]float64{{99.5, 1}, {99, 5}, {98.5, 3}},
+			asks: [][]float64{{102, 1}, {103, 4}},
+		},
+		{
+			name:   "version gap",
+			data:   types.IncrementalDepth{Event: "update", Version: 14, Bids: [][]float64{{97, 1}}},
+			err:    true,
+			resync: true,
+		},
+		{
+			name: "update after gap waits for the snapshot",
+			data: types.IncrementalDepth{Event: "update", Version: 15, Bids: [][]float64{{97, 1}}},
+		},
+	}
+
+	for _, tt := range tests {
+		got = nil
+		tt.data.Ch = topic
+
+		err := cli.handleIncrementalDepth(topic, &tt.data)
+		if tt.err {
+			assert.NotNil(t, err, tt.name)
+		} else {
+			assert.Nil(t, err, tt.name)
+		}
+
+		if tt.bids == nil && tt.asks == nil {
+			assert.Nil(t, got, tt.name)
+		} else if assert.NotNil(t, got, tt.name) {
+			assert.Equal(t, tt.bids, got.Bids, tt.name)
+			assert.Equal(t, tt.asks, got.Asks, tt.name)
+			assert.Equal(t, tt.data.Version, got.Version, tt.name)
+		}
+
+		_, ok := cli.books[topic]
+		assert.Equal(t, tt.bids != nil, ok, tt.name)
+
+		if tt.resync {
+			for _, want := range []Request{{UnSub: topic, DataType: "incremental"}, {Sub: topic, DataType: "incremental"}} {
+				select {
+				case req := <-requests:
+					req.ID = ""
+					assert.Equal(t, want, req, tt.name)
+				case <-time.After(time.Second):
+					t.Fatalf("%s: %+v was not sent", tt.name, want)
+				}
+			}
+		}
+	}
+
+	assert.Empty(t, requests)
+}
+
+func TestSubscribePremiumIndexKline(t *testing.T) {
+	cli, err := NewIndexWsClient(&IndexWsClientCfg{
+		BaseURL: GlobalIndexWsBaseURL,
+		Debug:   true,
+	})
+	if err != nil {
+		t.Fatalf("Could not create websocket client, %s", err)
+	}
+
+	topic, err := cli.GetPremiumIndexKlineTopic(&IndexKlineTopicParam{
+		ContractCode: "BTC-USDT",
+		Interval:     usdmtypes.Minute1,
+	})
+	assert.Nil(t, err)
+
+	cli.OnIndexKline(topic, func(e *types.IndexKline) {
+		fmt.Printf("Topic: %s, Open: %v, Close: %v, Low: %v, High: %v\n", topic, e.Open, e.Close, e.Low, e.High)
+	})
+
+	cli.Subscribe(topic)
+
+	select {}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package marketws
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/go-playground/validator"
+	"github.com/linstohu/nexapi/htx/usdm/marketws/types"
+	usdmtypes "github.com/linstohu/nexapi/htx/usdm/rest/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+// IndexWsClient connects to the index websocket, which pushes premium index,
+// estimated funding rate, basis and mark price klines
+type IndexWsClient struct {
+	*MarketWsClient
+}
+
+type IndexWsClientCfg struct {
+	Debug         bool
+	BaseURL       string `validate:"required"`
+	AutoReconnect bool   `validate:"required"`
+	// Logger
+	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
+}
+
+func NewIndexWsClient(cfg *IndexWsClientCfg) (*IndexWsClient, error) {
+	cli, err := NewMarketWsClient(&MarketWsClientCfg{
+		Debug:         cfg.Debug,
+		BaseURL:       cfg.BaseURL,
+		AutoReconnect: cfg.AutoReconnect,
+		Logger:        cfg.Logger,
+		Dispatcher:    cfg.Dispatcher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &IndexWsClient{cli}, nil
+}
+
+// IndexKlineTopicParam, the index websocket uses 60min instead of 1hour
+type IndexKlineTopicParam struct {
+	ContractCode string                  `validate:"required"`
+	Interval     usdmtypes.KlineInterval `validate:"required,oneof=1min 5min 15min 30min 60min 4hour 1day 1week 1mon"`
+}
+
+func (m *IndexWsClient) GetPremiumIndexKlineTopic(params *IndexKlineTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("market.%s.premium_index.%s", params.ContractCode, params.Interval), nil
+}
+
+func (m *IndexWsClient) GetEstimatedRateKlineTopic(params *IndexKlineTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("market.%s.estimated_rate.%s", params.ContractCode, params.Interval), nil
+}
+
+func (m *IndexWsClient) GetMarkPriceKlineTopic(params *IndexKlineTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("market.%s.mark_price.%s", params.ContractCode, params.Interval), nil
+}
+
+type BasisTopicParam struct {
+	ContractCode   string                  `validate:"required"`
+	Interval       usdmtypes.KlineInterval `validate:"required,oneof=1min 5min 15min 30min 60min 4hour 1day 1week 1mon"`
+	BasisPriceType string                  `validate:"required,oneof=open close high low average"`
+}
+
+func (m *IndexWsClient) GetBasisTopic(params *BasisTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("market.%s.basis.%s.%s", params.ContractCode, params.Interval, params.BasisPriceType), nil
+}
+
+func (m *IndexWsClient) OnIndexKline(topic string, fn func(*types.IndexKline)) func() {
+	return utils.On(m, topic, fn)
+}
+
+func (m *IndexWsClient) OnBasis(topic string, fn func(*types.Basis)) func() {
+	return utils.On(m, topic, fn)
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package marketws
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/linstohu/nexapi/htx/usdm/marketws/types"
+)
+
+// orderBook is the local book of one incremental depth topic, keyed by price
+type orderBook struct {
+	version    int64
+	bids, asks map[float64]float64
+}
+
+func newOrderBook(data *types.IncrementalDepth) *orderBook {
+	b := &orderBook{
+		bids: make(map[float64]float64, len(data.Bids)),
+		asks: make(map[float64]float64, len(data.Asks)),
+	}
+
+	b.update(data)
+
+	return b
+}
+
+// update applies an update, an amount of 0 deletes the price level
+func (b *orderBook) update(data *types.IncrementalDepth) {
+	b.version = data.Version
+	apply(b.bids, data.Bids)
+	apply(b.asks, data.Asks)
+}
+
+func (b *orderBook) levels(data *types.IncrementalDepth) *types.OrderBook {
+	return &types.OrderBook{
+		Ch:      data.Ch,
+		Ts:      data.Ts,
+		Version: b.version,
+		Bids:    sortLevels(b.bids, true),
+		Asks:    sortLevels(b.asks, false),
+	}
+}
+
+func apply(side map[float64]float64, levels [][]float64) {
+	for _, v := range levels {
+		if len(v) < 2 {
+			continue
+		}
+		if v[1] == 0 {
+			delete(side, v[0])
+			continue
+		}
+		side[v[0]] = v[1]
+	}
+}
+
+func sortLevels(side map[float64]float64, desc bool) [][]float64 {
+	ret := make([][]float64, 0, len(side))
+	for price, amount := range side {
+		ret = append(ret, []float64{price, amount})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if desc {
+			return ret[i][0] > ret[j][0]
+		}
+		return ret[i][0] < ret[j][0]
+	})
+
+	return ret
+}
+
+// handleIncrementalDepth merges the tick into the local book and emits the merged book,
+// a version gap drops the book and resubscribes the topic to get a new snapshot
+func (m *MarketWsClient) handleIncrementalDepth(topic string, data *types.IncrementalDepth) error {
+	if data.Event == "snapshot" {
+		book := newOrderBook(data)
+		m.books[topic] = book
+		m.GetListeners(topic, book.levels(data))
+		return nil
+	}
+
+	book, ok := m.books[topic]
+	if !ok {
+		// wait for the snapshot
+		return nil
+	}
+
+	if data.Version != book.version+1 {
+		delete(m.books, topic)
+
+		if err := m.resync(topic); err != nil {
+			return err
+		}
+
+		return fmt.Errorf("%s: version gap, topic: %s, local: %d, pushed: %d, resubscribed", logPrefix, topic, book.version, data.Version)
+	}
+
+	book.update(data)
+	m.GetListeners(topic, book.levels(data))
+
+	return nil
+}
+
+// resync sends unsub and sub for the topic to get a new snapshot, the subscription is kept
+// so it is still restored on reconnect if sending fails
+func (m *MarketWsClient) resync(topic string) error {
+	err := m.send(&Request{
+		ID:       fmt.Sprintf("%v", rand.Uint32()),
+		UnSub:    topic,
+		DataType: dataType(topic),
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.send(&Request{
+		ID:       fmt.Sprintf("%v", rand.Uint32()),
+		Sub:      topic,
+		DataType: dataType(topic),
+	})
+}
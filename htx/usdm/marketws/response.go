@@ -28,6 +28,8 @@ type Request struct {
 	ID    string `json:"id,omitempty"`
 	Sub   string `json:"sub,omitempty"`
 	UnSub string `json:"unsub,omitempty"`
+	// DataType is incremental for depth.size_${size}.high_freq topics
+	DataType string `json:"data_type,omitempty"`
 }
 
 // AnyMessage represents either a JSON Response or SubscribedMessage.
@@ -39,6 +39,29 @@ func (m *MarketWsClient) handle(msg *SubscribedMessage) error {
 	}
 
 	switch {
+	case strings.HasSuffix(msg.Channel, ".high_freq"):
+		var data types.IncrementalDepth
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		return m.handleIncrementalDepth(msg.Channel, &data)
+	case strings.Contains(msg.Channel, ".premium_index."),
+		strings.Contains(msg.Channel, ".estimated_rate."),
+		strings.Contains(msg.Channel, ".mark_price."):
+		var data types.IndexKline
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		m.GetListeners(msg.Channel, &data)
+	case strings.Contains(msg.Channel, ".basis."):
+		var data types.Basis
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		m.GetListeners(msg.Channel, &data)
 	case strings.Contains(msg.Channel, "kline"):
 		var data types.Kline
 		err := json.Unmarshal(msg.Data, &data)
@@ -19,6 +19,7 @@ package marketws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator"
 	usdmtypes "github.com/linstohu/nexapi/htx/usdm/rest/types"
@@ -64,3 +65,27 @@ func (m *MarketWsClient) GetMarketTradeTopic(contractCode string) (string, error
 	}
 	return fmt.Sprintf("market.%s.trade.detail", contractCode), nil
 }
+
+type IncrementalDepthTopicParam struct {
+	ContractCode string `validate:"required"`
+	Size         int    `validate:"required,oneof=20 150"`
+}
+
+// GetIncrementalDepthTopic, listeners of the topic get the merged *types.OrderBook
+func (m *MarketWsClient) GetIncrementalDepthTopic(params *IncrementalDepthTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("market.%s.depth.size_%d.high_freq", params.ContractCode, params.Size), nil
+}
+
+// dataType returns the data_type sent along with sub and unsub requests of the topic
+func dataType(topic string) string {
+	if strings.HasSuffix(topic, ".high_freq") {
+		return "incremental"
+	}
+
+	return ""
+}
@@ -65,3 +65,47 @@ type MarketTrade struct {
 	Quantity      float64 `json:"quantity,omitempty"`
 	TradeTurnover float64 `json:"trade_turnover,omitempty"`
 }
+
+// IncrementalDepth is a tick of market.$contract_code.depth.size_${size}.high_freq,
+// Event is snapshot or update and the version of updates grows by 1
+type IncrementalDepth struct {
+	MrID    int64       `json:"mrid,omitempty"`
+	ID      int64       `json:"id,omitempty"`
+	Bids    [][]float64 `json:"bids,omitempty"`
+	Asks    [][]float64 `json:"asks,omitempty"`
+	Ts      int64       `json:"ts,omitempty"`
+	Version int64       `json:"version,omitempty"`
+	Ch      string      `json:"ch,omitempty"`
+	Event   string      `json:"event,omitempty"`
+}
+
+// OrderBook is the local book merged from IncrementalDepth,
+// bids are sorted in descending and asks in ascending order
+type OrderBook struct {
+	Ch      string      `json:"ch,omitempty"`
+	Ts      int64       `json:"ts,omitempty"`
+	Version int64       `json:"version,omitempty"`
+	Bids    [][]float64 `json:"bids,omitempty"`
+	Asks    [][]float64 `json:"asks,omitempty"`
+}
+
+// IndexKline is pushed on premium_index, estimated_rate and mark_price topics of the index websocket
+type IndexKline struct {
+	ID            int64  `json:"id,omitempty"`
+	Open          string `json:"open,omitempty"`
+	Close         string `json:"close,omitempty"`
+	Low           string `json:"low,omitempty"`
+	High          string `json:"high,omitempty"`
+	Amount        string `json:"amount,omitempty"`
+	Vol           string `json:"vol,omitempty"`
+	Count         int    `json:"count,omitempty"`
+	TradeTurnover string `json:"trade_turnover,omitempty"`
+}
+
+type Basis struct {
+	ID            int64  `json:"id,omitempty"`
+	IndexPrice    string `json:"index_price,omitempty"`
+	ContractPrice string `json:"contract_price,omitempty"`
+	Basis         string `json:"basis,omitempty"`
+	BasisRate     string `json:"basis_rate,omitempty"`
+}
@@ -249,3 +249,115 @@ func (ucli *UsdmClient) GetUnifiedAccounts(ctx context.Context, param types.GetU
 
 	return &ret, nil
 }
+
+func (ucli *UsdmClient) GetIsolatedPositions(ctx context.Context, param types.GetIsolatedPositionsParam) (*types.GetPositionsResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_position_info",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.GetPositionsResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossPositions(ctx context.Context, param types.GetCrossPositionsParam) (*types.GetPositionsResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_position_info",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.GetPositionsResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
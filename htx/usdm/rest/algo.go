@@ -0,0 +1,1707 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/linstohu/nexapi/htx/usdm/rest/types"
+	"github.com/linstohu/nexapi/htx/utils"
+)
+
+func (ucli *UsdmClient) PlaceIsolatedTriggerOrder(ctx context.Context, param types.PlaceIsolatedTriggerOrderParam) (*types.PlaceAlgoOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_trigger_order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.PlaceAlgoOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelIsolatedTriggerOrder(ctx context.Context, param types.CancelIsolatedAlgoOrderParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_trigger_cancel",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelAllIsolatedTriggerOrders(ctx context.Context, param types.CancelAllIsolatedAlgoOrdersParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_trigger_cancelall",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedTriggerOpenOrders(ctx context.Context, param types.GetIsolatedAlgoOpenOrdersParam) (*types.TriggerOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_trigger_openorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TriggerOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedTriggerHistoryOrders(ctx context.Context, param types.GetIsolatedAlgoHistoryOrdersParam) (*types.TriggerOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_trigger_hisorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TriggerOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) PlaceCrossTriggerOrder(ctx context.Context, param types.PlaceCrossTriggerOrderParam) (*types.PlaceAlgoOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_trigger_order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.PlaceAlgoOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelCrossTriggerOrder(ctx context.Context, param types.CancelCrossAlgoOrderParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_trigger_cancel",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelAllCrossTriggerOrders(ctx context.Context, param types.CancelAllCrossAlgoOrdersParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_trigger_cancelall",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossTriggerOpenOrders(ctx context.Context, param types.GetCrossAlgoOpenOrdersParam) (*types.TriggerOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_trigger_openorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TriggerOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossTriggerHistoryOrders(ctx context.Context, param types.GetCrossAlgoHistoryOrdersParam) (*types.TriggerOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_trigger_hisorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TriggerOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) PlaceIsolatedTpslOrder(ctx context.Context, param types.PlaceIsolatedTpslOrderParam) (*types.PlaceTpslOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_tpsl_order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.PlaceTpslOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelIsolatedTpslOrder(ctx context.Context, param types.CancelIsolatedAlgoOrderParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_tpsl_cancel",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelAllIsolatedTpslOrders(ctx context.Context, param types.CancelAllIsolatedAlgoOrdersParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_tpsl_cancelall",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedTpslOpenOrders(ctx context.Context, param types.GetIsolatedAlgoOpenOrdersParam) (*types.TpslOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_tpsl_openorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TpslOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedTpslHistoryOrders(ctx context.Context, param types.GetIsolatedTpslHistoryOrdersParam) (*types.TpslOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_tpsl_hisorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TpslOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) PlaceCrossTpslOrder(ctx context.Context, param types.PlaceCrossTpslOrderParam) (*types.PlaceTpslOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_tpsl_order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.PlaceTpslOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelCrossTpslOrder(ctx context.Context, param types.CancelCrossAlgoOrderParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_tpsl_cancel",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelAllCrossTpslOrders(ctx context.Context, param types.CancelAllCrossAlgoOrdersParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_tpsl_cancelall",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossTpslOpenOrders(ctx context.Context, param types.GetCrossAlgoOpenOrdersParam) (*types.TpslOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_tpsl_openorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TpslOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossTpslHistoryOrders(ctx context.Context, param types.GetCrossTpslHistoryOrdersParam) (*types.TpslOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_tpsl_hisorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TpslOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) PlaceIsolatedTrackOrder(ctx context.Context, param types.PlaceIsolatedTrackOrderParam) (*types.PlaceAlgoOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_track_order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.PlaceAlgoOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelIsolatedTrackOrder(ctx context.Context, param types.CancelIsolatedAlgoOrderParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_track_cancel",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelAllIsolatedTrackOrders(ctx context.Context, param types.CancelAllIsolatedAlgoOrdersParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_track_cancelall",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedTrackOpenOrders(ctx context.Context, param types.GetIsolatedAlgoOpenOrdersParam) (*types.TrackOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_track_openorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TrackOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedTrackHistoryOrders(ctx context.Context, param types.GetIsolatedAlgoHistoryOrdersParam) (*types.TrackOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_track_hisorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TrackOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) PlaceCrossTrackOrder(ctx context.Context, param types.PlaceCrossTrackOrderParam) (*types.PlaceAlgoOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_track_order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.PlaceAlgoOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelCrossTrackOrder(ctx context.Context, param types.CancelCrossAlgoOrderParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_track_cancel",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelAllCrossTrackOrders(ctx context.Context, param types.CancelAllCrossAlgoOrdersParam) (*types.CancelOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_track_cancelall",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossTrackOpenOrders(ctx context.Context, param types.GetCrossAlgoOpenOrdersParam) (*types.TrackOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_track_openorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TrackOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossTrackHistoryOrders(ctx context.Context, param types.GetCrossAlgoHistoryOrdersParam) (*types.TrackOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_track_hisorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.TrackOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
@@ -170,3 +170,89 @@ func TestGetCrossHistoryMatchResults(t *testing.T) {
 
 	assert.Nil(t, err)
 }
+
+func TestGetCrossOrderInfo(t *testing.T) {
+	cli := testNewUsdmClient(t)
+
+	_, err := cli.GetCrossOrderInfo(context.TODO(), types.GetCrossOrderInfoParam{
+		OrderID:      "1",
+		ContractCode: "XRP-USDT",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestGetCrossHistoryOrders(t *testing.T) {
+	cli := testNewUsdmClient(t)
+
+	_, err := cli.GetCrossHistoryOrders(context.TODO(), types.GetCrossHistoryOrdersParam{
+		Contract: "XRP-USDT",
+		Type:     1,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestGetCrossTriggerOpenOrders(t *testing.T) {
+	cli := testNewUsdmClient(t)
+
+	_, err := cli.GetCrossTriggerOpenOrders(context.TODO(), types.GetCrossAlgoOpenOrdersParam{
+		ContractCode: "XRP-USDT",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestGetCrossTpslOpenOrders(t *testing.T) {
+	cli := testNewUsdmClient(t)
+
+	_, err := cli.GetCrossTpslOpenOrders(context.TODO(), types.GetCrossAlgoOpenOrdersParam{
+		ContractCode: "XRP-USDT",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestGetCrossTrackOpenOrders(t *testing.T) {
+	cli := testNewUsdmClient(t)
+
+	_, err := cli.GetCrossTrackOpenOrders(context.TODO(), types.GetCrossAlgoOpenOrdersParam{
+		ContractCode: "XRP-USDT",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestSwitchCrossLeverRate(t *testing.T) {
+	cli := testNewUsdmClient(t)
+
+	_, err := cli.SwitchCrossLeverRate(context.TODO(), types.SwitchCrossLeverRateParam{
+		ContractCode: "XRP-USDT",
+		LeverRate:    5,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestCancelAfter(t *testing.T) {
+	cli := testNewUsdmClient(t)
+
+	_, err := cli.CancelAfter(context.TODO(), types.CancelAfterParam{
+		OnOff:   1,
+		TimeOut: 30000,
+	})
+	assert.Nil(t, err)
+
+	_, err = cli.CancelAfter(context.TODO(), types.CancelAfterParam{
+		OnOff: 0,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetCrossPositions(t *testing.T) {
+	cli := testNewUsdmClient(t)
+
+	_, err := cli.GetCrossPositions(context.TODO(), types.GetCrossPositionsParam{})
+
+	assert.Nil(t, err)
+}
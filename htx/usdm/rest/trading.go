@@ -585,3 +585,843 @@ func (ucli *UsdmClient) GetCrossHistoryMatchResults(ctx context.Context, param t
 
 	return &ret, nil
 }
+
+func (ucli *UsdmClient) BatchPlaceIsolatedOrders(ctx context.Context, param types.BatchPlaceIsolatedOrdersParam) (*types.BatchOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_batchorder",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.BatchOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) BatchPlaceCrossOrders(ctx context.Context, param types.BatchPlaceCrossOrdersParam) (*types.BatchOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_batchorder",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.BatchOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedOrderInfo(ctx context.Context, param types.GetIsolatedOrderInfoParam) (*types.GetIsolatedOrderInfoResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_order_info",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.GetIsolatedOrderInfoResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossOrderInfo(ctx context.Context, param types.GetCrossOrderInfoParam) (*types.GetCrossOrderInfoResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_order_info",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.GetCrossOrderInfoResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedOrderDetail(ctx context.Context, param types.GetIsolatedOrderDetailParam) (*types.GetOrderDetailResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_order_detail",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.GetOrderDetailResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossOrderDetail(ctx context.Context, param types.GetCrossOrderDetailParam) (*types.GetOrderDetailResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_order_detail",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.GetOrderDetailResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetIsolatedHistoryOrders(ctx context.Context, param types.GetIsolatedHistoryOrdersParam) (*types.HistoryOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v3/swap_hisorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.HistoryOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) GetCrossHistoryOrders(ctx context.Context, param types.GetCrossHistoryOrdersParam) (*types.HistoryOrdersResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v3/swap_cross_hisorders",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.HistoryOrdersResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) IsolatedLightningClosePosition(ctx context.Context, param types.IsolatedLightningCloseParam) (*types.PlaceOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_lightning_close_position",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.PlaceOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CrossLightningClosePosition(ctx context.Context, param types.CrossLightningCloseParam) (*types.PlaceOrderResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_lightning_close_position",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.PlaceOrderResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) SwitchIsolatedPositionMode(ctx context.Context, param types.SwitchPositionModeParam) (*types.SwitchPositionModeResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_switch_position_mode",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.SwitchPositionModeResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) SwitchCrossPositionMode(ctx context.Context, param types.SwitchPositionModeParam) (*types.SwitchPositionModeResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_switch_position_mode",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.SwitchPositionModeResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) SwitchIsolatedLeverRate(ctx context.Context, param types.SwitchIsolatedLeverRateParam) (*types.SwitchLeverRateResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_switch_lever_rate",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.SwitchLeverRateResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) SwitchCrossLeverRate(ctx context.Context, param types.SwitchCrossLeverRateParam) (*types.SwitchLeverRateResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/swap_cross_switch_lever_rate",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.SwitchLeverRateResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
+
+func (ucli *UsdmClient) CancelAfter(ctx context.Context, param types.CancelAfterParam) (*types.CancelAfterResp, error) {
+	if err := ucli.cli.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	err := ucli.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		BaseURL: ucli.cli.GetBaseURL(),
+		Path:    "/linear-swap-api/v1/linear-cancel-after",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	{
+		headers, err := ucli.cli.GetHeaders()
+		if err != nil {
+			return nil, err
+		}
+		req.Headers = headers
+	}
+
+	{
+		query := ucli.cli.GenAuthParams()
+
+		signStr, err := ucli.cli.NormalizeRequestContent(req, query)
+		if err != nil {
+			return nil, err
+		}
+
+		h := ucli.cli.Sign([]byte(signStr))
+		if err != nil {
+			return nil, err
+		}
+
+		query.Signature = h
+
+		req.Query = query
+	}
+
+	resp, err := ucli.cli.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret types.CancelAfterResp
+	if err := resp.ReadJsonBody(&ret); err != nil {
+		return nil, errors.New(resp.Error())
+	}
+
+	return &ret, nil
+}
@@ -173,3 +173,43 @@ type UnifiedAccount struct {
 	CrossFuture       []CrossFuture `json:"cross_future,omitempty"`
 	CrossSwap         []CrossSwap   `json:"cross_swap,omitempty"`
 }
+
+type GetIsolatedPositionsParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+}
+
+type GetCrossPositionsParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair         string `json:"pair,omitempty" validate:"omitempty"`
+	ContractType string `json:"contract_type,omitempty" validate:"omitempty"`
+}
+
+type GetPositionsResp struct {
+	DefaultResponse
+	Data []Position `json:"data"`
+}
+
+type Position struct {
+	Symbol         string  `json:"symbol,omitempty"`
+	ContractCode   string  `json:"contract_code,omitempty"`
+	ContractType   string  `json:"contract_type,omitempty"`
+	Pair           string  `json:"pair,omitempty"`
+	BusinessType   string  `json:"business_type,omitempty"`
+	Volume         float64 `json:"volume,omitempty"`
+	Available      float64 `json:"available,omitempty"`
+	Frozen         float64 `json:"frozen,omitempty"`
+	CostOpen       float64 `json:"cost_open,omitempty"`
+	CostHold       float64 `json:"cost_hold,omitempty"`
+	ProfitUnreal   float64 `json:"profit_unreal,omitempty"`
+	ProfitRate     float64 `json:"profit_rate,omitempty"`
+	LeverRate      int     `json:"lever_rate,omitempty"`
+	PositionMargin float64 `json:"position_margin,omitempty"`
+	Direction      string  `json:"direction,omitempty"`
+	Profit         float64 `json:"profit,omitempty"`
+	LastPrice      float64 `json:"last_price,omitempty"`
+	MarginAsset    string  `json:"margin_asset,omitempty"`
+	MarginMode     string  `json:"margin_mode,omitempty"`
+	MarginAccount  string  `json:"margin_account,omitempty"`
+	PositionMode   string  `json:"position_mode,omitempty"`
+	AdlRiskPercent float64 `json:"adl_risk_percent,omitempty"`
+}
@@ -0,0 +1,335 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+type PlaceIsolatedTriggerOrderParam struct {
+	ContractCode   string  `json:"contract_code" validate:"required"`
+	TriggerType    string  `json:"trigger_type" validate:"required,oneof=ge le"`
+	TriggerPrice   float64 `json:"trigger_price" validate:"required"`
+	OrderPrice     float64 `json:"order_price,omitempty" validate:"omitempty"`
+	OrderPriceType string  `json:"order_price_type,omitempty" validate:"omitempty"`
+	Volume         int64   `json:"volume" validate:"required"`
+	Direction      string  `json:"direction" validate:"required,oneof=buy sell"`
+	Offset         string  `json:"offset,omitempty" validate:"omitempty"`
+	ReduceOnly     int     `json:"reduce_only,omitempty" validate:"omitempty"`
+	LeverRate      int     `json:"lever_rate,omitempty" validate:"omitempty"`
+}
+
+type PlaceCrossTriggerOrderParam struct {
+	ContractCode   string  `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair           string  `json:"pair,omitempty" validate:"omitempty"`
+	ContractType   string  `json:"contract_type,omitempty" validate:"omitempty"`
+	TriggerType    string  `json:"trigger_type" validate:"required,oneof=ge le"`
+	TriggerPrice   float64 `json:"trigger_price" validate:"required"`
+	OrderPrice     float64 `json:"order_price,omitempty" validate:"omitempty"`
+	OrderPriceType string  `json:"order_price_type,omitempty" validate:"omitempty"`
+	Volume         int64   `json:"volume" validate:"required"`
+	Direction      string  `json:"direction" validate:"required,oneof=buy sell"`
+	Offset         string  `json:"offset,omitempty" validate:"omitempty"`
+	ReduceOnly     int     `json:"reduce_only,omitempty" validate:"omitempty"`
+	LeverRate      int     `json:"lever_rate,omitempty" validate:"omitempty"`
+}
+
+type PlaceAlgoOrderResp struct {
+	DefaultResponse
+	Data struct {
+		OrderID    int64  `json:"order_id,omitempty"`
+		OrderIDStr string `json:"order_id_str,omitempty"`
+	} `json:"data"`
+}
+
+type CancelIsolatedAlgoOrderParam struct {
+	ContractCode string `json:"contract_code" validate:"required"`
+	OrderID      string `json:"order_id" validate:"required"`
+}
+
+type CancelCrossAlgoOrderParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair         string `json:"pair,omitempty" validate:"omitempty"`
+	ContractType string `json:"contract_type,omitempty" validate:"omitempty"`
+	OrderID      string `json:"order_id" validate:"required"`
+}
+
+type CancelAllIsolatedAlgoOrdersParam struct {
+	ContractCode string `json:"contract_code" validate:"required"`
+	Direction    string `json:"direction,omitempty" validate:"omitempty"`
+	Offset       string `json:"offset,omitempty" validate:"omitempty"`
+}
+
+type CancelAllCrossAlgoOrdersParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair         string `json:"pair,omitempty" validate:"omitempty"`
+	ContractType string `json:"contract_type,omitempty" validate:"omitempty"`
+	Direction    string `json:"direction,omitempty" validate:"omitempty"`
+	Offset       string `json:"offset,omitempty" validate:"omitempty"`
+}
+
+type GetIsolatedAlgoOpenOrdersParam struct {
+	ContractCode string `json:"contract_code" validate:"required"`
+	PageIndex    int    `json:"page_index,omitempty" validate:"omitempty"`
+	PageSize     int    `json:"page_size,omitempty" validate:"omitempty"`
+	TradeType    int    `json:"trade_type,omitempty" validate:"omitempty"`
+}
+
+type GetCrossAlgoOpenOrdersParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair         string `json:"pair,omitempty" validate:"omitempty"`
+	PageIndex    int    `json:"page_index,omitempty" validate:"omitempty"`
+	PageSize     int    `json:"page_size,omitempty" validate:"omitempty"`
+	TradeType    int    `json:"trade_type,omitempty" validate:"omitempty"`
+}
+
+type GetIsolatedAlgoHistoryOrdersParam struct {
+	ContractCode string `json:"contract_code" validate:"required"`
+	TradeType    int    `json:"trade_type"`
+	Status       string `json:"status" validate:"required"`
+	CreateDate   int    `json:"create_date" validate:"required"`
+	PageIndex    int    `json:"page_index,omitempty" validate:"omitempty"`
+	PageSize     int    `json:"page_size,omitempty" validate:"omitempty"`
+	SortBy       string `json:"sort_by,omitempty" validate:"omitempty"`
+}
+
+type GetCrossAlgoHistoryOrdersParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair         string `json:"pair,omitempty" validate:"omitempty"`
+	TradeType    int    `json:"trade_type"`
+	Status       string `json:"status" validate:"required"`
+	CreateDate   int    `json:"create_date" validate:"required"`
+	PageIndex    int    `json:"page_index,omitempty" validate:"omitempty"`
+	PageSize     int    `json:"page_size,omitempty" validate:"omitempty"`
+	SortBy       string `json:"sort_by,omitempty" validate:"omitempty"`
+}
+
+type TriggerOrdersResp struct {
+	DefaultResponse
+	Data TriggerOrders `json:"data"`
+}
+
+type TriggerOrders struct {
+	Orders      []TriggerOrder `json:"orders,omitempty"`
+	TotalPage   int            `json:"total_page,omitempty"`
+	CurrentPage int            `json:"current_page,omitempty"`
+	TotalSize   int            `json:"total_size,omitempty"`
+}
+
+type TriggerOrder struct {
+	Symbol          string  `json:"symbol,omitempty"`
+	ContractCode    string  `json:"contract_code,omitempty"`
+	ContractType    string  `json:"contract_type,omitempty"`
+	Pair            string  `json:"pair,omitempty"`
+	BusinessType    string  `json:"business_type,omitempty"`
+	TriggerType     string  `json:"trigger_type,omitempty"`
+	Volume          float64 `json:"volume,omitempty"`
+	OrderType       int     `json:"order_type,omitempty"`
+	Direction       string  `json:"direction,omitempty"`
+	Offset          string  `json:"offset,omitempty"`
+	LeverRate       int     `json:"lever_rate,omitempty"`
+	OrderID         int64   `json:"order_id,omitempty"`
+	OrderIDStr      string  `json:"order_id_str,omitempty"`
+	RelationOrderID string  `json:"relation_order_id,omitempty"`
+	OrderPriceType  string  `json:"order_price_type,omitempty"`
+	Status          int     `json:"status,omitempty"`
+	OrderSource     string  `json:"order_source,omitempty"`
+	TriggerPrice    float64 `json:"trigger_price,omitempty"`
+	TriggeredPrice  float64 `json:"triggered_price,omitempty"`
+	OrderPrice      float64 `json:"order_price,omitempty"`
+	CreatedAt       int64   `json:"created_at,omitempty"`
+	TriggeredAt     int64   `json:"triggered_at,omitempty"`
+	OrderInsertAt   int64   `json:"order_insert_at,omitempty"`
+	CanceledAt      int64   `json:"canceled_at,omitempty"`
+	UpdateTime      int64   `json:"update_time,omitempty"`
+	FailCode        int     `json:"fail_code,omitempty"`
+	FailReason      string  `json:"fail_reason,omitempty"`
+	MarginMode      string  `json:"margin_mode,omitempty"`
+	MarginAccount   string  `json:"margin_account,omitempty"`
+	ReduceOnly      int     `json:"reduce_only,omitempty"`
+}
+
+type PlaceIsolatedTpslOrderParam struct {
+	ContractCode     string  `json:"contract_code" validate:"required"`
+	Direction        string  `json:"direction" validate:"required,oneof=buy sell"`
+	Volume           int64   `json:"volume" validate:"required"`
+	TpTriggerPrice   float64 `json:"tp_trigger_price,omitempty" validate:"required_without=SlTriggerPrice"`
+	TpOrderPrice     float64 `json:"tp_order_price,omitempty" validate:"omitempty"`
+	TpOrderPriceType string  `json:"tp_order_price_type,omitempty" validate:"omitempty"`
+	SlTriggerPrice   float64 `json:"sl_trigger_price,omitempty" validate:"omitempty"`
+	SlOrderPrice     float64 `json:"sl_order_price,omitempty" validate:"omitempty"`
+	SlOrderPriceType string  `json:"sl_order_price_type,omitempty" validate:"omitempty"`
+}
+
+type PlaceCrossTpslOrderParam struct {
+	ContractCode     string  `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair             string  `json:"pair,omitempty" validate:"omitempty"`
+	ContractType     string  `json:"contract_type,omitempty" validate:"omitempty"`
+	Direction        string  `json:"direction" validate:"required,oneof=buy sell"`
+	Volume           int64   `json:"volume" validate:"required"`
+	TpTriggerPrice   float64 `json:"tp_trigger_price,omitempty" validate:"required_without=SlTriggerPrice"`
+	TpOrderPrice     float64 `json:"tp_order_price,omitempty" validate:"omitempty"`
+	TpOrderPriceType string  `json:"tp_order_price_type,omitempty" validate:"omitempty"`
+	SlTriggerPrice   float64 `json:"sl_trigger_price,omitempty" validate:"omitempty"`
+	SlOrderPrice     float64 `json:"sl_order_price,omitempty" validate:"omitempty"`
+	SlOrderPriceType string  `json:"sl_order_price_type,omitempty" validate:"omitempty"`
+}
+
+type PlaceTpslOrderResp struct {
+	DefaultResponse
+	Data struct {
+		TpOrder *TpslOrderID `json:"tp_order,omitempty"`
+		SlOrder *TpslOrderID `json:"sl_order,omitempty"`
+	} `json:"data"`
+}
+
+type TpslOrderID struct {
+	OrderID    int64  `json:"order_id,omitempty"`
+	OrderIDStr string `json:"order_id_str,omitempty"`
+}
+
+type GetIsolatedTpslHistoryOrdersParam struct {
+	ContractCode string `json:"contract_code" validate:"required"`
+	Status       string `json:"status" validate:"required"`
+	CreateDate   int    `json:"create_date" validate:"required"`
+	PageIndex    int    `json:"page_index,omitempty" validate:"omitempty"`
+	PageSize     int    `json:"page_size,omitempty" validate:"omitempty"`
+	SortBy       string `json:"sort_by,omitempty" validate:"omitempty"`
+}
+
+type GetCrossTpslHistoryOrdersParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair         string `json:"pair,omitempty" validate:"omitempty"`
+	Status       string `json:"status" validate:"required"`
+	CreateDate   int    `json:"create_date" validate:"required"`
+	PageIndex    int    `json:"page_index,omitempty" validate:"omitempty"`
+	PageSize     int    `json:"page_size,omitempty" validate:"omitempty"`
+	SortBy       string `json:"sort_by,omitempty" validate:"omitempty"`
+}
+
+type TpslOrdersResp struct {
+	DefaultResponse
+	Data TpslOrders `json:"data"`
+}
+
+type TpslOrders struct {
+	Orders      []TpslOrder `json:"orders,omitempty"`
+	TotalPage   int         `json:"total_page,omitempty"`
+	CurrentPage int         `json:"current_page,omitempty"`
+	TotalSize   int         `json:"total_size,omitempty"`
+}
+
+type TpslOrder struct {
+	Symbol              string  `json:"symbol,omitempty"`
+	ContractCode        string  `json:"contract_code,omitempty"`
+	ContractType        string  `json:"contract_type,omitempty"`
+	Pair                string  `json:"pair,omitempty"`
+	BusinessType        string  `json:"business_type,omitempty"`
+	MarginMode          string  `json:"margin_mode,omitempty"`
+	MarginAccount       string  `json:"margin_account,omitempty"`
+	Volume              float64 `json:"volume,omitempty"`
+	OrderType           int     `json:"order_type,omitempty"`
+	TpslOrderType       string  `json:"tpsl_order_type,omitempty"`
+	Direction           string  `json:"direction,omitempty"`
+	OrderID             int64   `json:"order_id,omitempty"`
+	OrderIDStr          string  `json:"order_id_str,omitempty"`
+	OrderSource         string  `json:"order_source,omitempty"`
+	TriggerType         string  `json:"trigger_type,omitempty"`
+	TriggerPrice        float64 `json:"trigger_price,omitempty"`
+	TriggeredPrice      float64 `json:"triggered_price,omitempty"`
+	OrderPrice          float64 `json:"order_price,omitempty"`
+	OrderPriceType      string  `json:"order_price_type,omitempty"`
+	CreatedAt           int64   `json:"created_at,omitempty"`
+	UpdateTime          int64   `json:"update_time,omitempty"`
+	CanceledAt          int64   `json:"canceled_at,omitempty"`
+	TriggeredAt         int64   `json:"triggered_at,omitempty"`
+	Status              int     `json:"status,omitempty"`
+	SourceOrderID       string  `json:"source_order_id,omitempty"`
+	RelationTpslOrderID string  `json:"relation_tpsl_order_id,omitempty"`
+	RelationOrderID     string  `json:"relation_order_id,omitempty"`
+	FailCode            int     `json:"fail_code,omitempty"`
+	FailReason          string  `json:"fail_reason,omitempty"`
+}
+
+type PlaceIsolatedTrackOrderParam struct {
+	ContractCode   string  `json:"contract_code" validate:"required"`
+	ReduceOnly     int     `json:"reduce_only,omitempty" validate:"omitempty"`
+	Direction      string  `json:"direction" validate:"required,oneof=buy sell"`
+	Offset         string  `json:"offset,omitempty" validate:"omitempty"`
+	LeverRate      int     `json:"lever_rate,omitempty" validate:"omitempty"`
+	Volume         int64   `json:"volume" validate:"required"`
+	CallbackRate   float64 `json:"callback_rate" validate:"required"`
+	ActivePrice    float64 `json:"active_price" validate:"required"`
+	OrderPriceType string  `json:"order_price_type" validate:"required"`
+}
+
+type PlaceCrossTrackOrderParam struct {
+	ContractCode   string  `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair           string  `json:"pair,omitempty" validate:"omitempty"`
+	ContractType   string  `json:"contract_type,omitempty" validate:"omitempty"`
+	ReduceOnly     int     `json:"reduce_only,omitempty" validate:"omitempty"`
+	Direction      string  `json:"direction" validate:"required,oneof=buy sell"`
+	Offset         string  `json:"offset,omitempty" validate:"omitempty"`
+	LeverRate      int     `json:"lever_rate,omitempty" validate:"omitempty"`
+	Volume         int64   `json:"volume" validate:"required"`
+	CallbackRate   float64 `json:"callback_rate" validate:"required"`
+	ActivePrice    float64 `json:"active_price" validate:"required"`
+	OrderPriceType string  `json:"order_price_type" validate:"required"`
+}
+
+type TrackOrdersResp struct {
+	DefaultResponse
+	Data TrackOrders `json:"data"`
+}
+
+type TrackOrders struct {
+	Orders      []TrackOrder `json:"orders,omitempty"`
+	TotalPage   int          `json:"total_page,omitempty"`
+	CurrentPage int          `json:"current_page,omitempty"`
+	TotalSize   int          `json:"total_size,omitempty"`
+}
+
+type TrackOrder struct {
+	Symbol          string  `json:"symbol,omitempty"`
+	ContractCode    string  `json:"contract_code,omitempty"`
+	ContractType    string  `json:"contract_type,omitempty"`
+	Pair            string  `json:"pair,omitempty"`
+	BusinessType    string  `json:"business_type,omitempty"`
+	Volume          float64 `json:"volume,omitempty"`
+	OrderType       int     `json:"order_type,omitempty"`
+	Direction       string  `json:"direction,omitempty"`
+	Offset          string  `json:"offset,omitempty"`
+	LeverRate       int     `json:"lever_rate,omitempty"`
+	OrderID         int64   `json:"order_id,omitempty"`
+	OrderIDStr      string  `json:"order_id_str,omitempty"`
+	OrderSource     string  `json:"order_source,omitempty"`
+	CreatedAt       int64   `json:"created_at,omitempty"`
+	UpdateTime      int64   `json:"update_time,omitempty"`
+	CanceledAt      int64   `json:"canceled_at,omitempty"`
+	TriggeredAt     int64   `json:"triggered_at,omitempty"`
+	OrderPriceType  string  `json:"order_price_type,omitempty"`
+	Status          int     `json:"status,omitempty"`
+	CallbackRate    float64 `json:"callback_rate,omitempty"`
+	ActivePrice     float64 `json:"active_price,omitempty"`
+	IsActive        int     `json:"is_active,omitempty"`
+	FormulaPrice    float64 `json:"formula_price,omitempty"`
+	RealVolume      float64 `json:"real_volume,omitempty"`
+	TriggeredPrice  float64 `json:"triggered_price,omitempty"`
+	RelationOrderID string  `json:"relation_order_id,omitempty"`
+	FailCode        int     `json:"fail_code,omitempty"`
+	FailReason      string  `json:"fail_reason,omitempty"`
+	MarginMode      string  `json:"margin_mode,omitempty"`
+	MarginAccount   string  `json:"margin_account,omitempty"`
+	ReduceOnly      int     `json:"reduce_only,omitempty"`
+}
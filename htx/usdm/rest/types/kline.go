@@ -30,6 +30,10 @@ var (
 	Hour4    KlineInterval = "4hour"
 	Day1     KlineInterval = "1day"
 	Month1   KlineInterval = "1mon"
+
+	// Minute60 and Week1 are only used by the index websocket
+	Minute60 KlineInterval = "60min"
+	Week1    KlineInterval = "1week"
 )
 
 type GetKlineParam struct {
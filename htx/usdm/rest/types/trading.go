@@ -268,3 +268,264 @@ type HistoryMatchResult struct {
 	BusinessType     string  `json:"business_type,omitempty"`
 	ReduceOnly       int     `json:"reduce_only,omitempty"`
 }
+
+type BatchPlaceIsolatedOrdersParam struct {
+	OrdersData []PlaceIsolatedOrderParam `json:"orders_data" validate:"required,min=1,max=10,dive"`
+}
+
+type BatchPlaceCrossOrdersParam struct {
+	OrdersData []PlaceCrossOrderParam `json:"orders_data" validate:"required,min=1,max=10,dive"`
+}
+
+type BatchOrderResp struct {
+	DefaultResponse
+	Data struct {
+		Errors []struct {
+			Index   int    `json:"index,omitempty"`
+			ErrCode int    `json:"err_code,omitempty"`
+			ErrMsg  string `json:"err_msg,omitempty"`
+		} `json:"errors,omitempty"`
+		Success []struct {
+			Index         int    `json:"index,omitempty"`
+			OrderID       int64  `json:"order_id,omitempty"`
+			ClientOrderID int64  `json:"client_order_id,omitempty"`
+			OrderIDStr    string `json:"order_id_str,omitempty"`
+		} `json:"success,omitempty"`
+	} `json:"data"`
+}
+
+type GetIsolatedOrderInfoParam struct {
+	OrderID       string `json:"order_id,omitempty" validate:"required_without=ClientOrderID"`
+	ClientOrderID string `json:"client_order_id,omitempty" validate:"omitempty"`
+	ContractCode  string `json:"contract_code" validate:"required"`
+}
+
+type GetIsolatedOrderInfoResp struct {
+	DefaultResponse
+	Data []IsolatedOrder `json:"data"`
+}
+
+type GetCrossOrderInfoParam struct {
+	OrderID       string `json:"order_id,omitempty" validate:"required_without=ClientOrderID"`
+	ClientOrderID string `json:"client_order_id,omitempty" validate:"omitempty"`
+	ContractCode  string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair          string `json:"pair,omitempty" validate:"omitempty"`
+}
+
+type GetCrossOrderInfoResp struct {
+	DefaultResponse
+	Data []CrossOpenOrder `json:"data"`
+}
+
+type GetIsolatedOrderDetailParam struct {
+	ContractCode string `json:"contract_code" validate:"required"`
+	OrderID      int64  `json:"order_id" validate:"required"`
+	CreatedAt    int64  `json:"created_at,omitempty" validate:"omitempty"`
+	OrderType    int    `json:"order_type,omitempty" validate:"omitempty"`
+	PageIndex    int    `json:"page_index,omitempty" validate:"omitempty"`
+	PageSize     int    `json:"page_size,omitempty" validate:"omitempty"`
+}
+
+type GetCrossOrderDetailParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair         string `json:"pair,omitempty" validate:"omitempty"`
+	OrderID      int64  `json:"order_id" validate:"required"`
+	CreatedAt    int64  `json:"created_at,omitempty" validate:"omitempty"`
+	OrderType    int    `json:"order_type,omitempty" validate:"omitempty"`
+	PageIndex    int    `json:"page_index,omitempty" validate:"omitempty"`
+	PageSize     int    `json:"page_size,omitempty" validate:"omitempty"`
+}
+
+type GetOrderDetailResp struct {
+	DefaultResponse
+	Data OrderDetail `json:"data"`
+}
+
+type OrderDetail struct {
+	Symbol          string             `json:"symbol,omitempty"`
+	ContractCode    string             `json:"contract_code,omitempty"`
+	ContractType    string             `json:"contract_type,omitempty"`
+	Pair            string             `json:"pair,omitempty"`
+	BusinessType    string             `json:"business_type,omitempty"`
+	Volume          float64            `json:"volume,omitempty"`
+	Price           float64            `json:"price,omitempty"`
+	OrderPriceType  string             `json:"order_price_type,omitempty"`
+	Direction       string             `json:"direction,omitempty"`
+	Offset          string             `json:"offset,omitempty"`
+	LeverRate       int                `json:"lever_rate,omitempty"`
+	MarginFrozen    float64            `json:"margin_frozen,omitempty"`
+	Profit          float64            `json:"profit,omitempty"`
+	OrderSource     string             `json:"order_source,omitempty"`
+	CreatedAt       int64              `json:"created_at,omitempty"`
+	CanceledAt      int64              `json:"canceled_at,omitempty"`
+	FinalInterest   float64            `json:"final_interest,omitempty"`
+	AdjustValue     float64            `json:"adjust_value,omitempty"`
+	InstrumentPrice float64            `json:"instrument_price,omitempty"`
+	OrderID         int64              `json:"order_id,omitempty"`
+	OrderIDStr      string             `json:"order_id_str,omitempty"`
+	ClientOrderID   int64              `json:"client_order_id,omitempty"`
+	OrderType       int                `json:"order_type,omitempty"`
+	Status          int                `json:"status,omitempty"`
+	TradeAvgPrice   float64            `json:"trade_avg_price,omitempty"`
+	TradeTurnover   float64            `json:"trade_turnover,omitempty"`
+	TradeVolume     float64            `json:"trade_volume,omitempty"`
+	Fee             float64            `json:"fee,omitempty"`
+	FeeAsset        string             `json:"fee_asset,omitempty"`
+	LiquidationType string             `json:"liquidation_type,omitempty"`
+	MarginAsset     string             `json:"margin_asset,omitempty"`
+	MarginMode      string             `json:"margin_mode,omitempty"`
+	MarginAccount   string             `json:"margin_account,omitempty"`
+	IsTpsl          int                `json:"is_tpsl,omitempty"`
+	RealProfit      float64            `json:"real_profit,omitempty"`
+	ReduceOnly      int                `json:"reduce_only,omitempty"`
+	TotalPage       int                `json:"total_page,omitempty"`
+	CurrentPage     int                `json:"current_page,omitempty"`
+	TotalSize       int                `json:"total_size,omitempty"`
+	Trades          []OrderDetailTrade `json:"trades,omitempty"`
+}
+
+type OrderDetailTrade struct {
+	ID            string  `json:"id,omitempty"`
+	TradeID       int64   `json:"trade_id,omitempty"`
+	TradePrice    float64 `json:"trade_price,omitempty"`
+	TradeVolume   float64 `json:"trade_volume,omitempty"`
+	TradeTurnover float64 `json:"trade_turnover,omitempty"`
+	TradeFee      float64 `json:"trade_fee,omitempty"`
+	Role          string  `json:"role,omitempty"`
+	CreatedAt     int64   `json:"created_at,omitempty"`
+	FeeAsset      string  `json:"fee_asset,omitempty"`
+	RealProfit    float64 `json:"real_profit,omitempty"`
+	Profit        float64 `json:"profit,omitempty"`
+}
+
+type GetIsolatedHistoryOrdersParam struct {
+	Contract  string `json:"contract" validate:"required"`
+	TradeType int    `json:"trade_type"`
+	Type      int    `json:"type" validate:"required,oneof=1 2"`
+	Status    string `json:"status,omitempty" validate:"omitempty"`
+	StartTime int64  `json:"start_time,omitempty"`
+	EndTime   int64  `json:"end_time,omitempty"`
+	Direct    string `json:"direct,omitempty" validate:"omitempty,oneof=next prev"`
+	FromID    int64  `json:"from_id,omitempty"`
+}
+
+type GetCrossHistoryOrdersParam struct {
+	Contract  string `json:"contract,omitempty" validate:"omitempty"`
+	Pair      string `json:"pair,omitempty" validate:"omitempty"`
+	TradeType int    `json:"trade_type"`
+	Type      int    `json:"type" validate:"required,oneof=1 2"`
+	Status    string `json:"status,omitempty" validate:"omitempty"`
+	StartTime int64  `json:"start_time,omitempty"`
+	EndTime   int64  `json:"end_time,omitempty"`
+	Direct    string `json:"direct,omitempty" validate:"omitempty,oneof=next prev"`
+	FromID    int64  `json:"from_id,omitempty"`
+}
+
+type HistoryOrdersResp struct {
+	DefaultResponse
+	Data []HistoryOrder `json:"data"`
+}
+
+type HistoryOrder struct {
+	QueryID         int64   `json:"query_id,omitempty"`
+	OrderID         int64   `json:"order_id,omitempty"`
+	OrderIDStr      string  `json:"order_id_str,omitempty"`
+	Symbol          string  `json:"symbol,omitempty"`
+	ContractCode    string  `json:"contract_code,omitempty"`
+	ContractType    string  `json:"contract_type,omitempty"`
+	Pair            string  `json:"pair,omitempty"`
+	BusinessType    string  `json:"business_type,omitempty"`
+	MarginMode      string  `json:"margin_mode,omitempty"`
+	MarginAccount   string  `json:"margin_account,omitempty"`
+	LeverRate       int     `json:"lever_rate,omitempty"`
+	Direction       string  `json:"direction,omitempty"`
+	Offset          string  `json:"offset,omitempty"`
+	Volume          float64 `json:"volume,omitempty"`
+	Price           float64 `json:"price,omitempty"`
+	CreateDate      int64   `json:"create_date,omitempty"`
+	UpdateTime      int64   `json:"update_time,omitempty"`
+	OrderSource     string  `json:"order_source,omitempty"`
+	OrderPriceType  int     `json:"order_price_type,omitempty"`
+	OrderType       int     `json:"order_type,omitempty"`
+	MarginFrozen    float64 `json:"margin_frozen,omitempty"`
+	Profit          float64 `json:"profit,omitempty"`
+	RealProfit      float64 `json:"real_profit,omitempty"`
+	TradeVolume     float64 `json:"trade_volume,omitempty"`
+	TradeTurnover   float64 `json:"trade_turnover,omitempty"`
+	Fee             float64 `json:"fee,omitempty"`
+	FeeAsset        string  `json:"fee_asset,omitempty"`
+	TradeAvgPrice   float64 `json:"trade_avg_price,omitempty"`
+	Status          int     `json:"status,omitempty"`
+	LiquidationType string  `json:"liquidation_type,omitempty"`
+	IsTpsl          int     `json:"is_tpsl,omitempty"`
+	ReduceOnly      int     `json:"reduce_only,omitempty"`
+	CanceledSource  string  `json:"canceled_source,omitempty"`
+}
+
+type IsolatedLightningCloseParam struct {
+	ContractCode   string `json:"contract_code" validate:"required"`
+	Volume         int64  `json:"volume" validate:"required"`
+	Direction      string `json:"direction" validate:"required,oneof=buy sell"`
+	ClientOrderID  int64  `json:"client_order_id,omitempty" validate:"omitempty"`
+	OrderPriceType string `json:"order_price_type,omitempty" validate:"omitempty"`
+}
+
+type CrossLightningCloseParam struct {
+	ContractCode   string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair           string `json:"pair,omitempty" validate:"omitempty"`
+	ContractType   string `json:"contract_type,omitempty" validate:"omitempty"`
+	Volume         int64  `json:"volume" validate:"required"`
+	Direction      string `json:"direction" validate:"required,oneof=buy sell"`
+	ClientOrderID  int64  `json:"client_order_id,omitempty" validate:"omitempty"`
+	OrderPriceType string `json:"order_price_type,omitempty" validate:"omitempty"`
+}
+
+type SwitchPositionModeParam struct {
+	MarginAccount string `json:"margin_account" validate:"required"`
+	PositionMode  string `json:"position_mode" validate:"required,oneof=single_side dual_side"`
+}
+
+type SwitchPositionModeResp struct {
+	DefaultResponse
+	Data []struct {
+		MarginAccount string `json:"margin_account,omitempty"`
+		PositionMode  string `json:"position_mode,omitempty"`
+	} `json:"data"`
+}
+
+type SwitchIsolatedLeverRateParam struct {
+	ContractCode string `json:"contract_code" validate:"required"`
+	LeverRate    int    `json:"lever_rate" validate:"required"`
+}
+
+type SwitchCrossLeverRateParam struct {
+	ContractCode string `json:"contract_code,omitempty" validate:"omitempty"`
+	Pair         string `json:"pair,omitempty" validate:"omitempty"`
+	ContractType string `json:"contract_type,omitempty" validate:"omitempty"`
+	LeverRate    int    `json:"lever_rate" validate:"required"`
+}
+
+type SwitchLeverRateResp struct {
+	DefaultResponse
+	Data struct {
+		ContractCode string `json:"contract_code,omitempty"`
+		ContractType string `json:"contract_type,omitempty"`
+		Pair         string `json:"pair,omitempty"`
+		BusinessType string `json:"business_type,omitempty"`
+		LeverRate    int    `json:"lever_rate,omitempty"`
+		MarginMode   string `json:"margin_mode,omitempty"`
+	} `json:"data"`
+}
+
+type CancelAfterParam struct {
+	OnOff   int `json:"on_off" validate:"oneof=0 1"`
+	TimeOut int `json:"time_out,omitempty" validate:"omitempty,min=5000"`
+}
+
+type CancelAfterResp struct {
+	DefaultResponse
+	Data struct {
+		CurrentTime int64 `json:"current_time,omitempty"`
+		TriggerTime int64 `json:"trigger_time,omitempty"`
+	} `json:"data"`
+}
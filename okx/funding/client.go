@@ -0,0 +1,406 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package funding
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-playground/validator"
+	"github.com/linstohu/nexapi/okx/funding/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type FundingClient struct {
+	*okxutils.OKXRestClient
+
+	// validate struct fields
+	validate *validator.Validate
+}
+
+type FundingClientCfg struct {
+	BaseURL    string `validate:"required"`
+	Key        string `validate:"required"`
+	Secret     string `validate:"required"`
+	Passphrase string `validate:"required"`
+	Debug      bool
+	// Demo switches to demo trading
+	Demo bool
+	// Logger
+	Logger *slog.Logger
+}
+
+func NewFundingClient(cfg *FundingClientCfg) (*FundingClient, error) {
+	validator := validator.New()
+
+	err := validator.Struct(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := okxutils.NewOKXRestClient(&okxutils.OKXRestClientCfg{
+		Debug:      cfg.Debug,
+		Demo:       cfg.Demo,
+		Logger:     cfg.Logger,
+		BaseURL:    cfg.BaseURL,
+		Key:        cfg.Key,
+		Secret:     cfg.Secret,
+		Passphrase: cfg.Passphrase,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &FundingClient{
+		OKXRestClient: cli,
+		validate:      validator,
+	}, nil
+}
+
+func (f *FundingClient) GetCurrencies(ctx context.Context, param types.CcyParam) (*types.GetCurrenciesResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/currencies",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetCurrenciesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetBalances returns the balances of the funding account
+func (f *FundingClient) GetBalances(ctx context.Context, param types.CcyParam) (*types.GetBalancesResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/balances",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetBalancesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (f *FundingClient) GetAssetValuation(ctx context.Context, param types.CcyParam) (*types.GetAssetValuationResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/asset-valuation",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetAssetValuationResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (f *FundingClient) Transfer(ctx context.Context, param types.TransferParam) (*types.TransferResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/transfer",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.TransferResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (f *FundingClient) GetTransferState(ctx context.Context, param types.GetTransferStateParam) (*types.GetTransferStateResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/transfer-state",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetTransferStateResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (f *FundingClient) GetDepositAddress(ctx context.Context, param types.GetDepositAddressParam) (*types.GetDepositAddressResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/deposit-address",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetDepositAddressResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (f *FundingClient) GetDepositHistory(ctx context.Context, param types.GetDepositHistoryParam) (*types.GetDepositHistoryResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/deposit-history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetDepositHistoryResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (f *FundingClient) Withdrawal(ctx context.Context, param types.WithdrawalParam) (*types.WithdrawalResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/withdrawal",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.WithdrawalResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (f *FundingClient) CancelWithdrawal(ctx context.Context, param types.CancelWithdrawalParam) (*types.CancelWithdrawalResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/cancel-withdrawal",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.CancelWithdrawalResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (f *FundingClient) GetWithdrawalHistory(ctx context.Context, param types.GetWithdrawalHistoryParam) (*types.GetWithdrawalHistoryResp, error) {
+	err := f.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   f.GetDebug(),
+		BaseURL: f.GetBaseURL(),
+		Path:    "/api/v5/asset/withdrawal-history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := f.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := f.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetWithdrawalHistoryResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package funding
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/linstohu/nexapi/okx/funding/types"
+	"github.com/linstohu/nexapi/okx/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNewFundingClient(t *testing.T) *FundingClient {
+	cli, err := NewFundingClient(&FundingClientCfg{
+		Debug:      true,
+		BaseURL:    utils.RestURL,
+		Key:        os.Getenv("OKX_KEY"),
+		Secret:     os.Getenv("OKX_SECRET"),
+		Passphrase: os.Getenv("OKX_PASS"),
+	})
+
+	if err != nil {
+		t.Fatalf("Could not create okx funding client, %s", err)
+	}
+
+	return cli
+}
+
+func TestGetBalances(t *testing.T) {
+	cli := testNewFundingClient(t)
+
+	_, err := cli.GetBalances(context.TODO(), types.CcyParam{})
+	assert.Nil(t, err)
+}
+
+func TestGetDepositHistory(t *testing.T) {
+	cli := testNewFundingClient(t)
+
+	_, err := cli.GetDepositHistory(context.TODO(), types.GetDepositHistoryParam{})
+	assert.Nil(t, err)
+}
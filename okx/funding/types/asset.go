@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+// CcyParam takes a single currency or multiple currencies separated with comma, e.g. BTC,ETH
+type CcyParam struct {
+	Ccy string `url:"ccy,omitempty"`
+}
+
+type GetCurrenciesResp struct {
+	okxutils.Response
+	Data []*Currency `json:"data"`
+}
+
+// Currency
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-currencies
+type Currency struct {
+	Ccy                  string `json:"ccy"`
+	Name                 string `json:"name"`
+	LogoLink             string `json:"logoLink"`
+	Chain                string `json:"chain"`
+	CanDep               bool   `json:"canDep"`
+	CanWd                bool   `json:"canWd"`
+	CanInternal          bool   `json:"canInternal"`
+	MinDep               string `json:"minDep"`
+	MinWd                string `json:"minWd"`
+	MaxWd                string `json:"maxWd"`
+	WdTickSz             string `json:"wdTickSz"`
+	WdQuota              string `json:"wdQuota"`
+	UsedWdQuota          string `json:"usedWdQuota"`
+	MinFee               string `json:"minFee"`
+	MaxFee               string `json:"maxFee"`
+	MainNet              bool   `json:"mainNet"`
+	NeedTag              bool   `json:"needTag"`
+	MinDepArrivalConfirm string `json:"minDepArrivalConfirm"`
+	MinWdUnlockConfirm   string `json:"minWdUnlockConfirm"`
+	DepQuotaFixed        string `json:"depQuotaFixed"`
+	UsedDepQuotaFixed    string `json:"usedDepQuotaFixed"`
+	DepQuoteDailyLayer2  string `json:"depQuoteDailyLayer2"`
+}
+
+type GetBalancesResp struct {
+	okxutils.Response
+	Data []*Balance `json:"data"`
+}
+
+// Balance of the funding account
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-balance
+type Balance struct {
+	Ccy       string `json:"ccy"`
+	Bal       string `json:"bal"`
+	FrozenBal string `json:"frozenBal"`
+	AvailBal  string `json:"availBal"`
+}
+
+type GetAssetValuationResp struct {
+	okxutils.Response
+	Data []*AssetValuation `json:"data"`
+}
+
+// AssetValuation
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-account-asset-valuation
+type AssetValuation struct {
+	TotalBal string `json:"totalBal"`
+	Ts       string `json:"ts"`
+	Details  struct {
+		Funding string `json:"funding"`
+		Trading string `json:"trading"`
+		Classic string `json:"classic"`
+		Earn    string `json:"earn"`
+	} `json:"details"`
+}
+
+// TransferParam transfers funds between the funding account (6) and the trading account (18)
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-funds-transfer
+type TransferParam struct {
+	// 0: within account, 1: master account to sub-account, 2: sub-account to master account,
+	// 3: sub-account to master account (only with sub-account APIKey), 4: sub-account to sub-account
+	Type        string `json:"type,omitempty" validate:"omitempty,oneof=0 1 2 3 4"`
+	Ccy         string `json:"ccy" validate:"required"`
+	Amt         string `json:"amt" validate:"required"`
+	From        string `json:"from" validate:"required,oneof=6 18"`
+	To          string `json:"to" validate:"required,oneof=6 18"`
+	SubAcct     string `json:"subAcct,omitempty"`
+	LoanTrans   bool   `json:"loanTrans,omitempty"`
+	OmitPosRisk string `json:"omitPosRisk,omitempty"`
+	ClientId    string `json:"clientId,omitempty"`
+}
+
+type TransferResp struct {
+	okxutils.Response
+	Data []*TransferResult `json:"data"`
+}
+
+type TransferResult struct {
+	TransId  string `json:"transId"`
+	Ccy      string `json:"ccy"`
+	ClientId string `json:"clientId"`
+	From     string `json:"from"`
+	Amt      string `json:"amt"`
+	To       string `json:"to"`
+}
+
+type GetTransferStateParam struct {
+	TransId  string `url:"transId,omitempty" validate:"required_without=ClientId"`
+	ClientId string `url:"clientId,omitempty"`
+	Type     string `url:"type,omitempty" validate:"omitempty,oneof=0 1 2 3 4"`
+}
+
+type GetTransferStateResp struct {
+	okxutils.Response
+	Data []*TransferState `json:"data"`
+}
+
+// TransferState
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-funds-transfer-state
+type TransferState struct {
+	TransId  string `json:"transId"`
+	ClientId string `json:"clientId"`
+	Ccy      string `json:"ccy"`
+	Amt      string `json:"amt"`
+	Type     string `json:"type"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	SubAcct  string `json:"subAcct"`
+	InstId   string `json:"instId"`
+	ToInstId string `json:"toInstId"`
+	State    string `json:"state"`
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+type GetDepositAddressParam struct {
+	Ccy string `url:"ccy" validate:"required"`
+}
+
+type GetDepositAddressResp struct {
+	okxutils.Response
+	Data []*DepositAddress `json:"data"`
+}
+
+// DepositAddress
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-deposit-address
+type DepositAddress struct {
+	Addr         string `json:"addr"`
+	Tag          string `json:"tag"`
+	Memo         string `json:"memo"`
+	PmtId        string `json:"pmtId"`
+	AddrEx       any    `json:"addrEx"`
+	Ccy          string `json:"ccy"`
+	Chain        string `json:"chain"`
+	To           string `json:"to"`
+	VerifiedName string `json:"verifiedName"`
+	Selected     bool   `json:"selected"`
+	CtAddr       string `json:"ctAddr"`
+}
+
+// GetDepositHistoryParam, After and Before are timestamps in milliseconds
+type GetDepositHistoryParam struct {
+	Ccy      string `url:"ccy,omitempty"`
+	DepId    string `url:"depId,omitempty"`
+	FromWdId string `url:"fromWdId,omitempty"`
+	TxId     string `url:"txId,omitempty"`
+	Type     string `url:"type,omitempty" validate:"omitempty,oneof=3 4"`
+	State    string `url:"state,omitempty"`
+	After    string `url:"after,omitempty"`
+	Before   string `url:"before,omitempty"`
+	Limit    int    `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetDepositHistoryResp struct {
+	okxutils.Response
+	Data []*Deposit `json:"data"`
+}
+
+// Deposit
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-deposit-history
+type Deposit struct {
+	Ccy                 string `json:"ccy"`
+	Chain               string `json:"chain"`
+	Amt                 string `json:"amt"`
+	From                string `json:"from"`
+	AreaCodeFrom        string `json:"areaCodeFrom"`
+	To                  string `json:"to"`
+	TxId                string `json:"txId"`
+	Ts                  string `json:"ts"`
+	State               string `json:"state"`
+	DepId               string `json:"depId"`
+	FromWdId            string `json:"fromWdId"`
+	ActualDepBlkConfirm string `json:"actualDepBlkConfirm"`
+}
+
+// WithdrawalParam, Dest 3 is internal transfer and 4 is on-chain withdrawal
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-withdrawal
+type WithdrawalParam struct {
+	Ccy      string `json:"ccy" validate:"required"`
+	Amt      string `json:"amt" validate:"required"`
+	Dest     string `json:"dest" validate:"required,oneof=3 4"`
+	ToAddr   string `json:"toAddr" validate:"required"`
+	Fee      string `json:"fee,omitempty"`
+	Chain    string `json:"chain,omitempty"`
+	AreaCode string `json:"areaCode,omitempty"`
+	RcvrInfo any    `json:"rcvrInfo,omitempty"`
+	ClientId string `json:"clientId,omitempty"`
+}
+
+type WithdrawalResp struct {
+	okxutils.Response
+	Data []*WithdrawalResult `json:"data"`
+}
+
+type WithdrawalResult struct {
+	Ccy      string `json:"ccy"`
+	Chain    string `json:"chain"`
+	Amt      string `json:"amt"`
+	WdId     string `json:"wdId"`
+	ClientId string `json:"clientId"`
+}
+
+type CancelWithdrawalParam struct {
+	WdId string `json:"wdId" validate:"required"`
+}
+
+type CancelWithdrawalResp struct {
+	okxutils.Response
+	Data []*CancelWithdrawalParam `json:"data"`
+}
+
+// GetWithdrawalHistoryParam, After and Before are timestamps in milliseconds
+type GetWithdrawalHistoryParam struct {
+	Ccy      string `url:"ccy,omitempty"`
+	WdId     string `url:"wdId,omitempty"`
+	ClientId string `url:"clientId,omitempty"`
+	TxId     string `url:"txId,omitempty"`
+	Type     string `url:"type,omitempty" validate:"omitempty,oneof=3 4"`
+	State    string `url:"state,omitempty"`
+	After    string `url:"after,omitempty"`
+	Before   string `url:"before,omitempty"`
+	Limit    int    `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetWithdrawalHistoryResp struct {
+	okxutils.Response
+	Data []*Withdrawal `json:"data"`
+}
+
+// Withdrawal
+// doc: https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-withdrawal-history
+type Withdrawal struct {
+	Ccy              string `json:"ccy"`
+	Chain            string `json:"chain"`
+	NonTradableAsset bool   `json:"nonTradableAsset"`
+	Amt              string `json:"amt"`
+	Ts               string `json:"ts"`
+	From             string `json:"from"`
+	AreaCodeFrom     string `json:"areaCodeFrom"`
+	To               string `json:"to"`
+	AreaCodeTo       string `json:"areaCodeTo"`
+	Tag              string `json:"tag"`
+	PmtId            string `json:"pmtId"`
+	Memo             string `json:"memo"`
+	AddrEx           any    `json:"addrEx"`
+	TxId             string `json:"txId"`
+	Fee              string `json:"fee"`
+	FeeCcy           string `json:"feeCcy"`
+	State            string `json:"state"`
+	WdId             string `json:"wdId"`
+	ClientId         string `json:"clientId"`
+}
@@ -0,0 +1,482 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package privatews
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/gorilla/websocket"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+	"github.com/linstohu/nexapi/utils"
+	cmap "github.com/orcaman/concurrent-map/v2"
+)
+
+type PrivateWsClient struct {
+	baseURL                 string
+	key, secret, passphrase string
+	// debug mode
+	debug bool
+	// logger
+	logger *slog.Logger
+	// validate struct fields
+	validate *validator.Validate
+
+	stopCtx context.Context
+	cancel  context.CancelFunc
+
+	conn        *websocket.Conn
+	mu          sync.RWMutex
+	isConnected bool
+
+	autoReconnect bool
+
+	sending       sync.Mutex
+	subscriptions cmap.ConcurrentMap[string, *okxutils.Arg]
+
+	// pending holds operation requests waiting for the response with the same id
+	reqID   atomic.Uint64
+	pending cmap.ConcurrentMap[string, chan *okxutils.WsMessage]
+
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
+}
+
+type PrivateWsClientCfg struct {
+	Debug         bool
+	BaseURL       string `validate:"required"`
+	AutoReconnect bool   `validate:"required"`
+	// Demo connects to the demo trading equivalent of BaseURL
+	Demo bool
+
+	Key        string `validate:"required"`
+	Secret     string `validate:"required"`
+	Passphrase string `validate:"required"`
+
+	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
+}
+
+func NewPrivateWsClient(cfg *PrivateWsClientCfg) (*PrivateWsClient, error) {
+	validator := validator.New()
+
+	if err := validator.Struct(cfg); err != nil {
+		return nil, err
+	}
+
+	cli := &PrivateWsClient{
+		debug:   cfg.Debug,
+		baseURL: okxutils.GetWsURL(cfg.BaseURL, cfg.Demo),
+		logger:  cfg.Logger,
+
+		validate: validator,
+
+		key:        cfg.Key,
+		secret:     cfg.Secret,
+		passphrase: cfg.Passphrase,
+
+		autoReconnect: cfg.AutoReconnect,
+
+		subscriptions: cmap.New[*okxutils.Arg](),
+		pending:       cmap.New[chan *okxutils.WsMessage](),
+		emitter:       utils.NewEmitter(),
+	}
+
+	if cli.logger == nil {
+		cli.logger = slog.Default()
+	}
+
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
+	return cli, nil
+}
+
+func (p *PrivateWsClient) Open() error {
+	if p.stopCtx != nil {
+		return fmt.Errorf("%s: ws is already open", logPrefix)
+	}
+
+	p.stopCtx, p.cancel = context.WithCancel(context.Background())
+
+	err := p.start()
+	if err != nil {
+		// do not keep reconnecting when the first connection fails
+		p.cancel()
+		return err
+	}
+
+	return nil
+}
+
+func (p *PrivateWsClient) Close() error {
+	if p.stopCtx == nil {
+		return fmt.Errorf("%s: ws is not open", logPrefix)
+	}
+
+	p.cancel()
+
+	if p.dispatcher != nil {
+		p.dispatcher.Close()
+	}
+
+	return nil
+}
+
+func (p *PrivateWsClient) start() error {
+	p.setIsConnected(false)
+
+	// per connection state is handed to the goroutines, so a reconnect does not race with them
+	var (
+		conn        *websocket.Conn
+		heartCancel = make(chan struct{})
+		disconnect  = make(chan struct{})
+		loginResult = make(chan error, 1)
+	)
+
+	for i := 0; i < MaxTryTimes; i++ {
+		c, _, err := p.connect()
+		if err != nil {
+			p.logger.Info(fmt.Sprintf("%s: connect error, times(%v), error: %s", logPrefix, i, err.Error()))
+			tm := (i + 1) * 5
+			time.Sleep(time.Duration(tm) * time.Second)
+			continue
+		}
+		conn = c
+		break
+	}
+	if conn == nil {
+		return errors.New("connect failed")
+	}
+
+	p.logger.Info(fmt.Sprintf("%s: connect success, base_url: %s", logPrefix, p.baseURL))
+
+	p.sending.Lock()
+	p.conn = conn
+	p.sending.Unlock()
+
+	p.setIsConnected(true)
+
+	// the login response is read by readMessages
+	go p.readMessages(conn, disconnect, loginResult)
+
+	// watch for disconnect before login, a failed login closes the connection and is retried by reconnect
+	if p.autoReconnect {
+		go p.reconnect(disconnect, heartCancel)
+	}
+
+	if err := p.login(loginResult); err != nil {
+		p.logger.Error(fmt.Sprintf("%s: login error, %s", logPrefix, err.Error()))
+		// readMessages sees the closed connection and calls close
+		conn.Close()
+		return err
+	}
+
+	p.resubscribe()
+
+	go p.heartbeat(heartCancel)
+
+	return nil
+}
+
+func (p *PrivateWsClient) connect() (*websocket.Conn, *http.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, p.baseURL, nil)
+	if err == nil {
+		conn.SetReadLimit(32768 * 64)
+	}
+
+	return conn, resp, err
+}
+
+func (p *PrivateWsClient) reconnect(disconnect, heartCancel chan struct{}) {
+	<-disconnect
+
+	p.setIsConnected(false)
+
+	close(heartCancel)
+
+	time.Sleep(1 * time.Second)
+
+	select {
+	case <-p.stopCtx.Done():
+		p.logger.Info(fmt.Sprintf("%s: reconnection exits", logPrefix))
+		return
+	default:
+		p.logger.Info(fmt.Sprintf("%s: try to reconnect...", logPrefix))
+		p.start()
+	}
+}
+
+// close closes the websocket connection
+func (p *PrivateWsClient) close(conn *websocket.Conn, disconnect chan struct{}) error {
+	// requests check the state after registering, so they are either failed here or not sent
+	p.setIsConnected(false)
+	p.failPending()
+
+	close(disconnect)
+
+	err := conn.Close()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setIsConnected sets state for isConnected
+func (p *PrivateWsClient) setIsConnected(state bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.isConnected = state
+}
+
+// IsConnected returns the WebSocket connection state
+func (p *PrivateWsClient) IsConnected() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.isConnected
+}
+
+// login signs in with the same key, secret and passphrase used for REST, then waits for the response
+func (p *PrivateWsClient) login(loginResult chan error) error {
+	args, err := okxutils.GenWsLoginArgs(p.key, p.secret, p.passphrase)
+	if err != nil {
+		return err
+	}
+
+	err = p.send(&okxutils.WsRequest{
+		Op:   okxutils.WsLogin,
+		Args: []*okxutils.WsLoginArgs{args},
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-loginResult:
+		return err
+	case <-time.After(LoginTimeout * time.Second):
+		return errors.New("login timeout")
+	}
+}
+
+// heartbeat sends a text ping every HeartbeatInterval seconds to keep alive
+func (p *PrivateWsClient) heartbeat(heartCancel chan struct{}) {
+	t := time.NewTicker(HeartbeatInterval * time.Second)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			p.ping()
+		case <-heartCancel:
+			return
+		}
+	}
+}
+
+func (p *PrivateWsClient) readMessages(conn *websocket.Conn, disconnect chan struct{}, loginResult chan error) {
+	for {
+		select {
+		case <-p.stopCtx.Done():
+			p.logger.Info(fmt.Sprintf("%s: ready to close...", logPrefix))
+
+			if err := p.close(conn, disconnect); err != nil {
+				p.logger.Error(fmt.Sprintf("%s: connection closed error, %s", logPrefix, err.Error()))
+				return
+			}
+
+			p.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
+			return
+		default:
+			_, buf, err := conn.ReadMessage()
+			if err != nil {
+				p.logger.Info(fmt.Sprintf("%s: read message error, %s", logPrefix, err))
+				p.logger.Info(fmt.Sprintf("%s: ready to close...", logPrefix))
+
+				if err := p.close(conn, disconnect); err != nil {
+					p.logger.Error(fmt.Sprintf("%s: connection closed error, %s", logPrefix, err.Error()))
+					return
+				}
+
+				p.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
+				return
+			}
+
+			if string(buf) == okxutils.WsPong {
+				continue
+			}
+
+			var msg okxutils.WsMessage
+			if err := json.Unmarshal(buf, &msg); err != nil {
+				p.logger.Info(fmt.Sprintf("%s: read object error, %s", logPrefix, err))
+				continue
+			}
+
+			switch {
+			case msg.ID != "":
+				p.deliver(&msg)
+			case msg.Event == okxutils.WsLogin:
+				setLoginResult(loginResult, nil)
+			case msg.Event == okxutils.WsError:
+				p.logger.Error(fmt.Sprintf("%s: websocket error, code: %s, msg: %s", logPrefix, msg.Code, msg.Msg))
+				// login failures are reported as error events as well
+				setLoginResult(loginResult, fmt.Errorf("code: %s, msg: %s", msg.Code, msg.Msg))
+			case msg.Event != "":
+				if p.debug {
+					p.logger.Info(fmt.Sprintf("%s: event: %s, conn_id: %s", logPrefix, msg.Event, msg.ConnId))
+				}
+			case msg.Arg != nil:
+				err := p.handle(&msg)
+				if err != nil {
+					p.logger.Info(fmt.Sprintf("%s: handle message error: %s", logPrefix, err.Error()))
+				}
+			}
+		}
+	}
+}
+
+func setLoginResult(loginResult chan error, err error) {
+	select {
+	case loginResult <- err:
+	default:
+	}
+}
+
+func (p *PrivateWsClient) resubscribe() error {
+	args := p.subscriptions.Items()
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	req := make([]*okxutils.Arg, 0, len(args))
+	for _, v := range args {
+		req = append(req, v)
+	}
+
+	return p.send(&okxutils.WsRequest{
+		Op:   okxutils.WsSubscribe,
+		Args: req,
+	})
+}
+
+func (p *PrivateWsClient) subscribe(topics []string) error {
+	args := make([]*okxutils.Arg, 0)
+	seen := make(map[string]bool)
+
+	for _, topic := range topics {
+		arg, err := okxutils.ParseTopic(topic)
+		if err != nil {
+			return err
+		}
+
+		// topics are keyed by their canonical form, whatever order the fields were written in
+		topic = arg.Topic()
+		if seen[topic] || p.subscriptions.Has(topic) {
+			continue
+		}
+		seen[topic] = true
+
+		args = append(args, arg)
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	// do subscription
+	err := p.send(&okxutils.WsRequest{
+		Op:   okxutils.WsSubscribe,
+		Args: args,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range args {
+		p.subscriptions.Set(v.Topic(), v)
+	}
+
+	return nil
+}
+
+func (p *PrivateWsClient) unsubscribe(topics []string) error {
+	args := make([]*okxutils.Arg, 0, len(topics))
+
+	for _, topic := range topics {
+		arg, err := okxutils.ParseTopic(topic)
+		if err != nil {
+			return err
+		}
+
+		args = append(args, arg)
+	}
+
+	err := p.send(&okxutils.WsRequest{
+		Op:   okxutils.WsUnsubscribe,
+		Args: args,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range args {
+		p.subscriptions.Remove(v.Topic())
+	}
+
+	return nil
+}
+
+func (p *PrivateWsClient) send(req *okxutils.WsRequest) error {
+	p.sending.Lock()
+	defer p.sending.Unlock()
+
+	if !p.IsConnected() {
+		return errors.New("connection is closed")
+	}
+
+	return p.conn.WriteJSON(req)
+}
+
+func (p *PrivateWsClient) ping() error {
+	p.sending.Lock()
+	defer p.sending.Unlock()
+
+	if !p.IsConnected() {
+		return errors.New("connection is closed")
+	}
+
+	return p.conn.WriteMessage(websocket.TextMessage, []byte(okxutils.WsPing))
+}
@@ -0,0 +1,287 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package privatews
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/linstohu/nexapi/okx/privatews/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNewPrivateWsClient(t *testing.T) *PrivateWsClient {
+	cli, err := NewPrivateWsClient(&PrivateWsClientCfg{
+		Debug:         true,
+		BaseURL:       okxutils.PrivateWsURL,
+		AutoReconnect: true,
+		Key:           os.Getenv("OKX_KEY"),
+		Secret:        os.Getenv("OKX_SECRET"),
+		Passphrase:    os.Getenv("OKX_PASS"),
+	})
+
+	if err != nil {
+		t.Fatalf("Could not create websocket client, %s", err)
+	}
+
+	err = cli.Open()
+	if err != nil {
+		t.Fatalf("Could not open websocket client, %s", err)
+	}
+
+	return cli
+}
+
+func TestSubscribeAccount(t *testing.T) {
+	cli := testNewPrivateWsClient(t)
+	defer cli.Close()
+
+	topic, err := cli.GetAccountTopic("")
+	assert.Nil(t, err)
+
+	cli.OnAccount(topic, func(e *types.Account) {
+		fmt.Printf("Topic: %s, TotalEq: %v, Details: %v\n", topic, e.TotalEq, len(e.Details))
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(10 * time.Second)
+}
+
+func TestSubscribeOrders(t *testing.T) {
+	cli := testNewPrivateWsClient(t)
+	defer cli.Close()
+
+	topic, err := cli.GetOrdersTopic(&OrdersTopicParam{
+		InstType: "ANY",
+	})
+	assert.Nil(t, err)
+
+	cli.OnOrder(topic, func(e *types.Order) {
+		fmt.Printf("Topic: %s, InstId: %v, OrdId: %v, State: %v\n", topic, e.InstId, e.OrdId, e.State)
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(10 * time.Second)
+}
+
+func TestPlaceAndCancelOrder(t *testing.T) {
+	cli := testNewPrivateWsClient(t)
+	defer cli.Close()
+
+	order, err := cli.PlaceOrder(context.TODO(), &types.PlaceOrderParam{
+		InstId:  "BTC-USDT",
+		TdMode:  "cash",
+		Side:    "buy",
+		OrdType: "post_only",
+		Sz:      "0.0001",
+		Px:      "10000",
+	})
+	assert.Nil(t, err)
+	fmt.Printf("OrdId: %v, SCode: %v, SMsg: %v\n", order.OrdId, order.SCode, order.SMsg)
+
+	cancel, err := cli.CancelOrder(context.TODO(), &types.CancelOrderParam{
+		InstId: "BTC-USDT",
+		OrdId:  order.OrdId,
+	})
+	assert.Nil(t, err)
+	fmt.Printf("OrdId: %v, SCode: %v, SMsg: %v\n", cancel.OrdId, cancel.SCode, cancel.SMsg)
+}
+
+// testServe runs a local websocket server, handle is called with the connection number and every request,
+// the connection is dropped when it returns false
+func testServe(t *testing.T, handle func(n int32, conn *websocket.Conn, req *okxutils.WsRequest) bool) (string, *atomic.Int32) {
+	var conns atomic.Int32
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		n := conns.Add(1)
+
+		for {
+			var req okxutils.WsRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+
+			if !handle(n, conn, &req) {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return "ws" + strings.TrimPrefix(srv.URL, "http"), &conns
+}
+
+func testNewLocalPrivateWsClient(t *testing.T, url string) *PrivateWsClient {
+	cli, err := NewPrivateWsClient(&PrivateWsClientCfg{
+		BaseURL:       url,
+		AutoReconnect: true,
+		Key:           "key",
+		Secret:        "secret",
+		Passphrase:    "passphrase",
+	})
+	if err != nil {
+		t.Fatalf("Could not create websocket client, %s", err)
+	}
+
+	err = cli.Open()
+	if err != nil {
+		t.Fatalf("Could not open websocket client, %s", err)
+	}
+	t.Cleanup(func() { cli.Close() })
+
+	return cli
+}
+
+func TestReconnectAfterLoginFailure(t *testing.T) {
+	url, conns := testServe(t, func(n int32, conn *websocket.Conn, req *okxutils.WsRequest) bool {
+		if req.Op != okxutils.WsLogin {
+			return true
+		}
+
+		switch n {
+		case 1:
+			// login, then drop the connection
+			conn.WriteJSON(okxutils.WsMessage{Event: okxutils.WsLogin, Code: "0"})
+			return false
+		case 2:
+			conn.WriteJSON(okxutils.WsMessage{Event: okxutils.WsError, Code: "50113", Msg: "Invalid Sign"})
+		default:
+			conn.WriteJSON(okxutils.WsMessage{Event: okxutils.WsLogin, Code: "0"})
+		}
+
+		return true
+	})
+
+	cli := testNewLocalPrivateWsClient(t, url)
+
+	assert.Eventually(t, func() bool {
+		return conns.Load() == 3 && cli.IsConnected()
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestPendingRequestFailsOnDisconnect(t *testing.T) {
+	url, _ := testServe(t, func(n int32, conn *websocket.Conn, req *okxutils.WsRequest) bool {
+		if req.Op == okxutils.WsLogin {
+			conn.WriteJSON(okxutils.WsMessage{Event: okxutils.WsLogin, Code: "0"})
+			return true
+		}
+
+		// drop the connection without answering the order
+		return false
+	})
+
+	cli := testNewLocalPrivateWsClient(t, url)
+
+	start := time.Now()
+	_, err := cli.PlaceOrder(context.TODO(), &types.PlaceOrderParam{
+		InstId:  "BTC-USDT",
+		TdMode:  "cash",
+		Side:    "buy",
+		OrdType: "limit",
+		Sz:      "0.0001",
+		Px:      "10000",
+	})
+	assert.ErrorIs(t, err, ErrDisconnected)
+	assert.Less(t, time.Since(start), RequestTimeout*time.Second)
+	assert.Empty(t, cli.pending.Keys())
+}
+
+func TestBatchOrdersLimit(t *testing.T) {
+	cli, err := NewPrivateWsClient(&PrivateWsClientCfg{
+		BaseURL:       okxutils.PrivateWsURL,
+		AutoReconnect: true,
+		Key:           "key",
+		Secret:        "secret",
+		Passphrase:    "passphrase",
+	})
+	assert.Nil(t, err)
+
+	orders := make([]*types.CancelOrderParam, 21)
+	for i := range orders {
+		orders[i] = &types.CancelOrderParam{InstId: "BTC-USDT", OrdId: "1"}
+	}
+
+	for _, v := range [][]*types.CancelOrderParam{nil, orders, {{InstId: "BTC-USDT"}}} {
+		err := cli.validate.Struct(&types.BatchCancelOrdersParam{Orders: v})
+		assert.NotNil(t, err)
+	}
+
+	err = cli.validate.Struct(&types.BatchCancelOrdersParam{Orders: orders[:20]})
+	assert.Nil(t, err)
+}
+
+func TestSubscribeCanonicalTopic(t *testing.T) {
+	requests := make(chan *okxutils.WsRequest, 8)
+
+	url, _ := testServe(t, func(n int32, conn *websocket.Conn, req *okxutils.WsRequest) bool {
+		if req.Op == okxutils.WsLogin {
+			conn.WriteJSON(okxutils.WsMessage{Event: okxutils.WsLogin, Code: "0"})
+			return true
+		}
+
+		requests <- req
+		return true
+	})
+
+	cli := testNewLocalPrivateWsClient(t, url)
+
+	topic := "orders:instType=SWAP:instId=BTC-USDT-SWAP"
+	reordered := "orders:instId=BTC-USDT-SWAP:instType=SWAP"
+
+	err := cli.Subscribe([]string{reordered, topic})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{topic}, cli.subscriptions.Keys())
+
+	req := <-requests
+	assert.Equal(t, okxutils.WsSubscribe, req.Op)
+	assert.Len(t, req.Args, 1)
+
+	// already subscribed under its canonical topic, nothing is sent
+	err = cli.Subscribe([]string{reordered})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{topic}, cli.subscriptions.Keys())
+
+	err = cli.UnSubscribe([]string{reordered})
+	assert.Nil(t, err)
+	assert.Empty(t, cli.subscriptions.Keys())
+
+	req = <-requests
+	assert.Equal(t, okxutils.WsUnsubscribe, req.Op)
+	assert.Len(t, req.Args, 1)
+	assert.Empty(t, requests)
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package privatews
+
+import (
+	"github.com/linstohu/nexapi/okx/privatews/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type Listener = utils.Listener
+
+// AddListener registers listener for event, the returned func removes it.
+func (p *PrivateWsClient) AddListener(event string, listener Listener) func() {
+	return p.emitter.On(event, listener)
+}
+
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (p *PrivateWsClient) RemoveListener(event string, listener Listener) {
+	p.emitter.Off(event, listener)
+}
+
+func (p *PrivateWsClient) GetListeners(event string, argument any) {
+	if p.dispatcher != nil {
+		p.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	p.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (p *PrivateWsClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if p.dispatcher == nil {
+		return nil
+	}
+
+	return p.dispatcher.Metrics()
+}
+
+func (p *PrivateWsClient) OnAccount(topic string, fn func(*types.Account)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateWsClient) OnPosition(topic string, fn func(*types.Position)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateWsClient) OnBalanceAndPosition(topic string, fn func(*types.BalanceAndPosition)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateWsClient) OnOrder(topic string, fn func(*types.Order)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PrivateWsClient) OnFill(topic string, fn func(*types.Fill)) func() {
+	return utils.On(p, topic, fn)
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package privatews
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/linstohu/nexapi/okx/privatews/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+func (p *PrivateWsClient) Subscribe(topics []string) error {
+	return p.subscribe(topics)
+}
+
+func (p *PrivateWsClient) UnSubscribe(topics []string) error {
+	return p.unsubscribe(topics)
+}
+
+func (p *PrivateWsClient) handle(msg *okxutils.WsMessage) error {
+	topic := msg.Arg.Topic()
+
+	if p.debug {
+		p.logger.Info(fmt.Sprintf("%s: subscribed message, topic: %s", logPrefix, topic))
+	}
+
+	switch msg.Arg.Channel {
+	case "account":
+		var data []*types.Account
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case "positions":
+		var data []*types.Position
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case "balance_and_position":
+		var data []*types.BalanceAndPosition
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case "orders":
+		var data []*types.Order
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case "fills":
+		var data []*types.Fill
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	default:
+		return fmt.Errorf("unknown message, topic: %s", topic)
+	}
+
+	return nil
+}
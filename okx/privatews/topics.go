@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package privatews
+
+import (
+	"github.com/go-playground/validator"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+// GetAccountTopic subscribes to all currencies when ccy is empty
+func (p *PrivateWsClient) GetAccountTopic(ccy string) (string, error) {
+	arg := okxutils.Arg{Channel: "account", Ccy: ccy}
+
+	return arg.Topic(), nil
+}
+
+type PositionsTopicParam struct {
+	InstType   string `validate:"required,oneof=MARGIN SWAP FUTURES OPTION ANY"`
+	InstFamily string
+	InstId     string
+}
+
+func (p *PrivateWsClient) GetPositionsTopic(params *PositionsTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	arg := okxutils.Arg{
+		Channel:    "positions",
+		InstType:   params.InstType,
+		InstFamily: params.InstFamily,
+		InstId:     params.InstId,
+	}
+
+	return arg.Topic(), nil
+}
+
+func (p *PrivateWsClient) GetBalanceAndPositionTopic() (string, error) {
+	arg := okxutils.Arg{Channel: "balance_and_position"}
+
+	return arg.Topic(), nil
+}
+
+type OrdersTopicParam struct {
+	InstType   string `validate:"required,oneof=SPOT MARGIN SWAP FUTURES OPTION ANY"`
+	InstFamily string
+	InstId     string
+}
+
+func (p *PrivateWsClient) GetOrdersTopic(params *OrdersTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	arg := okxutils.Arg{
+		Channel:    "orders",
+		InstType:   params.InstType,
+		InstFamily: params.InstFamily,
+		InstId:     params.InstId,
+	}
+
+	return arg.Topic(), nil
+}
+
+// GetFillsTopic subscribes to all instruments when instId is empty, only VIP6 and above can subscribe
+func (p *PrivateWsClient) GetFillsTopic(instId string) (string, error) {
+	arg := okxutils.Arg{Channel: "fills", InstId: instId}
+
+	return arg.Topic(), nil
+}
@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package privatews
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/linstohu/nexapi/okx/privatews/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+const (
+	OpOrder            = "order"
+	OpBatchOrders      = "batch-orders"
+	OpAmendOrder       = "amend-order"
+	OpBatchAmendOrders = "batch-amend-orders"
+	OpCancelOrder      = "cancel-order"
+	OpBatchCancelOrder = "batch-cancel-orders"
+	OpMassCancel       = "mass-cancel"
+)
+
+// ErrDisconnected is returned to operations waiting for a response when the connection drops,
+// the exchange may or may not have processed them, query the order state before retrying.
+var ErrDisconnected = errors.New("connection closed before the response arrived")
+
+// OpError is returned when an operation response has a non-zero code.
+// Code 1 means all orders failed and code 2 means some of them failed,
+// the per-order sCode and sMsg are returned along with the error in both cases.
+type OpError struct {
+	Op   string
+	Code string
+	Msg  string
+}
+
+func (e *OpError) Error() string {
+	return fmt.Sprintf("%s: op: %s, code: %s, msg: %s", logPrefix, e.Op, e.Code, e.Msg)
+}
+
+func (p *PrivateWsClient) PlaceOrder(ctx context.Context, param *types.PlaceOrderParam) (*types.OrderResult, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return first(p.orderRequest(ctx, OpOrder, []*types.PlaceOrderParam{param}))
+}
+
+// BatchPlaceOrders places up to 20 orders at a time
+func (p *PrivateWsClient) BatchPlaceOrders(ctx context.Context, param *types.BatchPlaceOrdersParam) ([]*types.OrderResult, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.orderRequest(ctx, OpBatchOrders, param.Orders)
+}
+
+func (p *PrivateWsClient) AmendOrder(ctx context.Context, param *types.AmendOrderParam) (*types.OrderResult, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return first(p.orderRequest(ctx, OpAmendOrder, []*types.AmendOrderParam{param}))
+}
+
+// BatchAmendOrders amends up to 20 orders at a time
+func (p *PrivateWsClient) BatchAmendOrders(ctx context.Context, param *types.BatchAmendOrdersParam) ([]*types.OrderResult, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.orderRequest(ctx, OpBatchAmendOrders, param.Orders)
+}
+
+func (p *PrivateWsClient) CancelOrder(ctx context.Context, param *types.CancelOrderParam) (*types.OrderResult, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return first(p.orderRequest(ctx, OpCancelOrder, []*types.CancelOrderParam{param}))
+}
+
+// BatchCancelOrders cancels up to 20 orders at a time
+func (p *PrivateWsClient) BatchCancelOrders(ctx context.Context, param *types.BatchCancelOrdersParam) ([]*types.OrderResult, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.orderRequest(ctx, OpBatchCancelOrder, param.Orders)
+}
+
+// MassCancel needs a client connected to okxutils.BusinessWsURL
+func (p *PrivateWsClient) MassCancel(ctx context.Context, param *types.MassCancelParam) (*types.MassCancelResult, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := p.request(ctx, OpMassCancel, []*types.MassCancelParam{param})
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*types.MassCancelResult
+	if len(msg.Data) > 0 {
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.Code != "0" {
+		return nil, &OpError{Op: msg.Op, Code: msg.Code, Msg: msg.Msg}
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%s: empty response, op: %s", logPrefix, msg.Op)
+	}
+
+	return data[0], nil
+}
+
+func (p *PrivateWsClient) orderRequest(ctx context.Context, op string, args any) ([]*types.OrderResult, error) {
+	msg, err := p.request(ctx, op, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*types.OrderResult
+	if len(msg.Data) > 0 {
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.Code != "0" {
+		return data, &OpError{Op: msg.Op, Code: msg.Code, Msg: msg.Msg}
+	}
+
+	return data, nil
+}
+
+// first unwraps the result of single order operations
+func first(data []*types.OrderResult, err error) (*types.OrderResult, error) {
+	if len(data) == 0 {
+		if err == nil {
+			err = fmt.Errorf("%s: empty response", logPrefix)
+		}
+		return nil, err
+	}
+
+	if err, ok := err.(*OpError); ok && data[0].SMsg != "" {
+		// sMsg is more specific than the operation level msg
+		err.Code, err.Msg = data[0].SCode, data[0].SMsg
+	}
+
+	return data[0], err
+}
+
+// request sends an operation and waits for the response with the same id
+func (p *PrivateWsClient) request(ctx context.Context, op string, args any) (*okxutils.WsMessage, error) {
+	id := strconv.FormatUint(p.reqID.Add(1), 10)
+
+	ch := make(chan *okxutils.WsMessage, 1)
+	p.pending.Set(id, ch)
+	defer p.pending.Remove(id)
+
+	err := p.send(&okxutils.WsRequest{
+		ID:   id,
+		Op:   op,
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, RequestTimeout*time.Second)
+		defer cancel()
+	}
+
+	select {
+	case msg := <-ch:
+		if msg == nil {
+			return nil, fmt.Errorf("%s: op: %s, id: %s, %w", logPrefix, op, id, ErrDisconnected)
+		}
+		return msg, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s: wait for response, op: %s, id: %s, %w", logPrefix, op, id, ctx.Err())
+	}
+}
+
+// deliver hands an operation response over to the waiting request
+func (p *PrivateWsClient) deliver(msg *okxutils.WsMessage) {
+	ch, ok := p.pending.Get(msg.ID)
+	if !ok {
+		if p.debug {
+			p.logger.Info(fmt.Sprintf("%s: no pending request, op: %s, id: %s", logPrefix, msg.Op, msg.ID))
+		}
+		return
+	}
+
+	select {
+	case ch <- msg:
+	default:
+	}
+}
+
+// failPending wakes every waiting request with ErrDisconnected,
+// responses arriving on a new connection can not be matched to them anymore
+func (p *PrivateWsClient) failPending() {
+	for _, id := range p.pending.Keys() {
+		ch, ok := p.pending.Pop(id)
+		if !ok {
+			continue
+		}
+
+		// a nil message means disconnected, a response already buffered wins
+		select {
+		case ch <- nil:
+		default:
+		}
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	tatypes "github.com/linstohu/nexapi/okx/tradingaccount/types"
+)
+
+// Account
+// doc: https://www.okx.com/docs-v5/en/#trading-account-websocket-account-channel
+type Account struct {
+	UTime       string                   `json:"uTime"`
+	TotalEq     string                   `json:"totalEq"`
+	IsoEq       string                   `json:"isoEq"`
+	AdjEq       string                   `json:"adjEq"`
+	OrdFroz     string                   `json:"ordFroz"`
+	Imr         string                   `json:"imr"`
+	Mmr         string                   `json:"mmr"`
+	BorrowFroz  string                   `json:"borrowFroz"`
+	MgnRatio    string                   `json:"mgnRatio"`
+	NotionalUsd string                   `json:"notionalUsd"`
+	Details     []*tatypes.BalanceDetail `json:"details"`
+}
+
+// Position has the same fields as the REST positions endpoint
+// doc: https://www.okx.com/docs-v5/en/#trading-account-websocket-positions-channel
+type Position = tatypes.Position
+
+// BalanceAndPosition
+// doc: https://www.okx.com/docs-v5/en/#trading-account-websocket-balance-and-position-channel
+type BalanceAndPosition struct {
+	PTime     string `json:"pTime"`
+	EventType string `json:"eventType"`
+	BalData   []struct {
+		Ccy     string `json:"ccy"`
+		CashBal string `json:"cashBal"`
+		UTime   string `json:"uTime"`
+	} `json:"balData"`
+	PosData []struct {
+		PosId    string `json:"posId"`
+		TradeId  string `json:"tradeId"`
+		InstId   string `json:"instId"`
+		InstType string `json:"instType"`
+		MgnMode  string `json:"mgnMode"`
+		PosSide  string `json:"posSide"`
+		Pos      string `json:"pos"`
+		Ccy      string `json:"ccy"`
+		PosCcy   string `json:"posCcy"`
+		AvgPx    string `json:"avgPx"`
+		UTime    string `json:"uTime"`
+	} `json:"posData"`
+	Trades []struct {
+		InstId  string `json:"instId"`
+		TradeId string `json:"tradeId"`
+	} `json:"trades"`
+}
+
+// Order
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-order-channel
+type Order struct {
+	InstType          string `json:"instType"`
+	InstId            string `json:"instId"`
+	TgtCcy            string `json:"tgtCcy"`
+	Ccy               string `json:"ccy"`
+	OrdId             string `json:"ordId"`
+	ClOrdId           string `json:"clOrdId"`
+	Tag               string `json:"tag"`
+	Px                string `json:"px"`
+	PxUsd             string `json:"pxUsd"`
+	PxVol             string `json:"pxVol"`
+	PxType            string `json:"pxType"`
+	Sz                string `json:"sz"`
+	NotionalUsd       string `json:"notionalUsd"`
+	OrdType           string `json:"ordType"`
+	Side              string `json:"side"`
+	PosSide           string `json:"posSide"`
+	TdMode            string `json:"tdMode"`
+	FillPx            string `json:"fillPx"`
+	TradeId           string `json:"tradeId"`
+	FillSz            string `json:"fillSz"`
+	FillPnl           string `json:"fillPnl"`
+	FillTime          string `json:"fillTime"`
+	FillFee           string `json:"fillFee"`
+	FillFeeCcy        string `json:"fillFeeCcy"`
+	FillNotionalUsd   string `json:"fillNotionalUsd"`
+	ExecType          string `json:"execType"`
+	AccFillSz         string `json:"accFillSz"`
+	AvgPx             string `json:"avgPx"`
+	State             string `json:"state"`
+	Lever             string `json:"lever"`
+	AttachAlgoClOrdId string `json:"attachAlgoClOrdId"`
+	TpTriggerPx       string `json:"tpTriggerPx"`
+	TpTriggerPxType   string `json:"tpTriggerPxType"`
+	TpOrdPx           string `json:"tpOrdPx"`
+	SlTriggerPx       string `json:"slTriggerPx"`
+	SlTriggerPxType   string `json:"slTriggerPxType"`
+	SlOrdPx           string `json:"slOrdPx"`
+	StpId             string `json:"stpId"`
+	StpMode           string `json:"stpMode"`
+	FeeCcy            string `json:"feeCcy"`
+	Fee               string `json:"fee"`
+	RebateCcy         string `json:"rebateCcy"`
+	Rebate            string `json:"rebate"`
+	Pnl               string `json:"pnl"`
+	Source            string `json:"source"`
+	CancelSource      string `json:"cancelSource"`
+	AmendSource       string `json:"amendSource"`
+	Category          string `json:"category"`
+	IsTpLimit         string `json:"isTpLimit"`
+	ReduceOnly        string `json:"reduceOnly"`
+	QuickMgnType      string `json:"quickMgnType"`
+	AlgoClOrdId       string `json:"algoClOrdId"`
+	AlgoId            string `json:"algoId"`
+	ReqId             string `json:"reqId"`
+	AmendResult       string `json:"amendResult"`
+	Code              string `json:"code"`
+	Msg               string `json:"msg"`
+	UTime             string `json:"uTime"`
+	CTime             string `json:"cTime"`
+}
+
+// Fill is only available for VIP6 and above
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-fills-channel
+type Fill struct {
+	InstId   string `json:"instId"`
+	FillSz   string `json:"fillSz"`
+	FillPx   string `json:"fillPx"`
+	Side     string `json:"side"`
+	Ts       string `json:"ts"`
+	OrdId    string `json:"ordId"`
+	TradeId  string `json:"tradeId"`
+	ExecType string `json:"execType"`
+	Count    string `json:"count"`
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+// PlaceOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-place-order
+type PlaceOrderParam struct {
+	InstId       string `json:"instId" validate:"required"`
+	TdMode       string `json:"tdMode" validate:"required,oneof=cash isolated cross spot_isolated"`
+	Ccy          string `json:"ccy,omitempty"`
+	ClOrdId      string `json:"clOrdId,omitempty"`
+	Tag          string `json:"tag,omitempty"`
+	Side         string `json:"side" validate:"required,oneof=buy sell"`
+	PosSide      string `json:"posSide,omitempty" validate:"omitempty,oneof=long short net"`
+	OrdType      string `json:"ordType" validate:"required,oneof=market limit post_only fok ioc optimal_limit_ioc mmp mmp_and_post_only"`
+	Sz           string `json:"sz" validate:"required"`
+	Px           string `json:"px,omitempty"`
+	PxUsd        string `json:"pxUsd,omitempty"`
+	PxVol        string `json:"pxVol,omitempty"`
+	ReduceOnly   bool   `json:"reduceOnly,omitempty"`
+	TgtCcy       string `json:"tgtCcy,omitempty" validate:"omitempty,oneof=base_ccy quote_ccy"`
+	BanAmend     bool   `json:"banAmend,omitempty"`
+	QuickMgnType string `json:"quickMgnType,omitempty"`
+	StpId        string `json:"stpId,omitempty"`
+	StpMode      string `json:"stpMode,omitempty" validate:"omitempty,oneof=cancel_maker cancel_taker cancel_both"`
+}
+
+// BatchPlaceOrdersParam places up to 20 orders at a time
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-place-multiple-orders
+type BatchPlaceOrdersParam struct {
+	Orders []*PlaceOrderParam `validate:"required,min=1,max=20,dive"`
+}
+
+// AmendOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-amend-order
+type AmendOrderParam struct {
+	InstId    string `json:"instId" validate:"required"`
+	CxlOnFail bool   `json:"cxlOnFail,omitempty"`
+	OrdId     string `json:"ordId,omitempty" validate:"required_without=ClOrdId"`
+	ClOrdId   string `json:"clOrdId,omitempty"`
+	ReqId     string `json:"reqId,omitempty"`
+	NewSz     string `json:"newSz,omitempty"`
+	NewPx     string `json:"newPx,omitempty"`
+	NewPxUsd  string `json:"newPxUsd,omitempty"`
+	NewPxVol  string `json:"newPxVol,omitempty"`
+}
+
+// BatchAmendOrdersParam amends up to 20 orders at a time
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-amend-multiple-orders
+type BatchAmendOrdersParam struct {
+	Orders []*AmendOrderParam `validate:"required,min=1,max=20,dive"`
+}
+
+// CancelOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-cancel-order
+type CancelOrderParam struct {
+	InstId  string `json:"instId" validate:"required"`
+	OrdId   string `json:"ordId,omitempty" validate:"required_without=ClOrdId"`
+	ClOrdId string `json:"clOrdId,omitempty"`
+}
+
+// BatchCancelOrdersParam cancels up to 20 orders at a time
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-cancel-multiple-orders
+type BatchCancelOrdersParam struct {
+	Orders []*CancelOrderParam `validate:"required,min=1,max=20,dive"`
+}
+
+// MassCancelParam cancels all MMP pending orders of an instrument family
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-mass-cancel-order
+type MassCancelParam struct {
+	InstType   string `json:"instType" validate:"required,oneof=OPTION"`
+	InstFamily string `json:"instFamily" validate:"required"`
+}
+
+// OrderResult is the per-order result of order, amend and cancel operations
+type OrderResult struct {
+	ClOrdId string `json:"clOrdId"`
+	OrdId   string `json:"ordId"`
+	Tag     string `json:"tag"`
+	ReqId   string `json:"reqId"`
+	Ts      string `json:"ts"`
+	SCode   string `json:"sCode"`
+	SMsg    string `json:"sMsg"`
+}
+
+type MassCancelResult struct {
+	Result bool `json:"result"`
+}
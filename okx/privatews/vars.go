@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package privatews
+
+const (
+	logPrefix = "okx::privatews"
+)
+
+const (
+	MaxTryTimes = 5
+
+	// the connection is closed by server if there is no data push within 30 seconds
+	HeartbeatInterval = 20
+
+	// LoginTimeout is how long to wait for the login response, in seconds
+	LoginTimeout = 10
+
+	// RequestTimeout is how long to wait for an operation response when ctx has no deadline, in seconds
+	RequestTimeout = 10
+)
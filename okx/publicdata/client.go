@@ -44,6 +44,7 @@ func NewPublicDataClient(cfg *okxutils.OKXRestClientCfg) (*PublicDataClient, err
 
 	cli, err := okxutils.NewOKXRestClient(&okxutils.OKXRestClientCfg{
 		Debug:   cfg.Debug,
+		Demo:    cfg.Demo,
 		Logger:  cfg.Logger,
 		BaseURL: cfg.BaseURL,
 	})
@@ -47,3 +47,38 @@ func TestGetInstruments(t *testing.T) {
 	})
 	assert.Nil(t, err)
 }
+
+func TestGetOrderBook(t *testing.T) {
+	cli := testNewPublicDataClient(t)
+
+	_, err := cli.GetOrderBook(context.TODO(), types.GetOrderBookParam{
+		InstId: "BTC-USDT",
+		Sz:     20,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetFundingRate(t *testing.T) {
+	cli := testNewPublicDataClient(t)
+
+	_, err := cli.GetFundingRate(context.TODO(), types.InstIdParam{
+		InstId: "BTC-USDT-SWAP",
+	})
+	assert.Nil(t, err)
+}
+
+func TestPageHistoryCandles(t *testing.T) {
+	cli := testNewPublicDataClient(t)
+
+	pages := 0
+	err := cli.PageHistoryCandles(context.TODO(), types.GetCandlesParam{
+		InstId: "BTC-USDT",
+		Bar:    "1H",
+		Limit:  100,
+	}, func(candles []*types.Candle) bool {
+		pages++
+		return pages < 3
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, pages)
+}
@@ -0,0 +1,395 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicdata
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linstohu/nexapi/okx/publicdata/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+// GetOrderBook returns up to 400 levels
+func (p *PublicDataClient) GetOrderBook(ctx context.Context, param types.GetOrderBookParam) (*types.GetOrderBookResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/books",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrderBookResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetFullOrderBook returns up to 5000 levels
+func (p *PublicDataClient) GetFullOrderBook(ctx context.Context, param types.GetOrderBookParam) (*types.GetOrderBookResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/books-full",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrderBookResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetCandles returns up to 1440 recent candles
+func (p *PublicDataClient) GetCandles(ctx context.Context, param types.GetCandlesParam) (*types.GetCandlesResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/candles",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetCandlesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetHistoryCandles returns candles of recent years
+func (p *PublicDataClient) GetHistoryCandles(ctx context.Context, param types.GetCandlesParam) (*types.GetCandlesResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/history-candles",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetCandlesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetTrades returns up to 500 recent trades
+func (p *PublicDataClient) GetTrades(ctx context.Context, param types.GetTradesParam) (*types.GetTradesResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/trades",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetTradesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetHistoryTrades returns trades of the last 3 months
+func (p *PublicDataClient) GetHistoryTrades(ctx context.Context, param types.GetHistoryTradesParam) (*types.GetTradesResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/history-trades",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetTradesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetIndexTickers(ctx context.Context, param types.GetIndexTickersParam) (*types.GetIndexTickersResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/index-tickers",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetIndexTickersResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetIndexCandles(ctx context.Context, param types.GetCandlesParam) (*types.GetPriceCandlesResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/index-candles",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPriceCandlesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetHistoryIndexCandles(ctx context.Context, param types.GetCandlesParam) (*types.GetPriceCandlesResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/history-index-candles",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPriceCandlesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetMarkPriceCandles(ctx context.Context, param types.GetCandlesParam) (*types.GetPriceCandlesResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/mark-price-candles",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPriceCandlesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetHistoryMarkPriceCandles(ctx context.Context, param types.GetCandlesParam) (*types.GetPriceCandlesResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/market/history-mark-price-candles",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPriceCandlesResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
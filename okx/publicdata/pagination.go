@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicdata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linstohu/nexapi/okx/publicdata/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+// PageCandles walks candles from newest to oldest, param.After sets where to start and param.Before where to stop.
+// fn is called with every page, return false to stop early.
+func (p *PublicDataClient) PageCandles(ctx context.Context, param types.GetCandlesParam, fn func([]*types.Candle) bool) error {
+	return paginate(ctx, param.After, func(after string) ([]*types.Candle, error) {
+		param.After = after
+		resp, err := p.GetCandles(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, checkResponse(resp.Response)
+	}, func(v *types.Candle) string { return v.Ts }, fn)
+}
+
+// PageHistoryCandles walks history candles from newest to oldest, see PageCandles.
+func (p *PublicDataClient) PageHistoryCandles(ctx context.Context, param types.GetCandlesParam, fn func([]*types.Candle) bool) error {
+	return paginate(ctx, param.After, func(after string) ([]*types.Candle, error) {
+		param.After = after
+		resp, err := p.GetHistoryCandles(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, checkResponse(resp.Response)
+	}, func(v *types.Candle) string { return v.Ts }, fn)
+}
+
+// PageHistoryIndexCandles walks history index candles from newest to oldest, see PageCandles.
+func (p *PublicDataClient) PageHistoryIndexCandles(ctx context.Context, param types.GetCandlesParam, fn func([]*types.PriceCandle) bool) error {
+	return paginate(ctx, param.After, func(after string) ([]*types.PriceCandle, error) {
+		param.After = after
+		resp, err := p.GetHistoryIndexCandles(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, checkResponse(resp.Response)
+	}, func(v *types.PriceCandle) string { return v.Ts }, fn)
+}
+
+// PageHistoryMarkPriceCandles walks history mark price candles from newest to oldest, see PageCandles.
+func (p *PublicDataClient) PageHistoryMarkPriceCandles(ctx context.Context, param types.GetCandlesParam, fn func([]*types.PriceCandle) bool) error {
+	return paginate(ctx, param.After, func(after string) ([]*types.PriceCandle, error) {
+		param.After = after
+		resp, err := p.GetHistoryMarkPriceCandles(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, checkResponse(resp.Response)
+	}, func(v *types.PriceCandle) string { return v.Ts }, fn)
+}
+
+// PageHistoryTrades walks trades from newest to oldest, the cursor is the trade id or the timestamp according to param.Type.
+func (p *PublicDataClient) PageHistoryTrades(ctx context.Context, param types.GetHistoryTradesParam, fn func([]*types.Trade) bool) error {
+	cursor := func(v *types.Trade) string { return v.TradeId }
+	if param.Type == "2" {
+		cursor = func(v *types.Trade) string { return v.Ts }
+	}
+
+	return paginate(ctx, param.After, func(after string) ([]*types.Trade, error) {
+		param.After = after
+		resp, err := p.GetHistoryTrades(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, checkResponse(resp.Response)
+	}, cursor, fn)
+}
+
+// PageFundingRateHistory walks funding rates from newest to oldest, see PageCandles.
+func (p *PublicDataClient) PageFundingRateHistory(ctx context.Context, param types.GetFundingRateHistoryParam, fn func([]*types.FundingRateHistory) bool) error {
+	return paginate(ctx, param.After, func(after string) ([]*types.FundingRateHistory, error) {
+		param.After = after
+		resp, err := p.GetFundingRateHistory(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, checkResponse(resp.Response)
+	}, func(v *types.FundingRateHistory) string { return v.FundingTime }, fn)
+}
+
+// paginate requests pages with the after cursor set to the last record of the previous page.
+// It stops when a page is empty, the cursor does not move or fn returns false.
+func paginate[T any](ctx context.Context, after string, fetch func(after string) ([]T, error), cursor func(T) string, fn func([]T) bool) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page, err := fetch(after)
+		if err != nil {
+			return err
+		}
+
+		if len(page) == 0 || !fn(page) {
+			return nil
+		}
+
+		next := cursor(page[len(page)-1])
+		if next == "" || next == after {
+			return nil
+		}
+		after = next
+	}
+}
+
+func checkResponse(resp okxutils.Response) error {
+	if resp.Code != "0" {
+		return fmt.Errorf("code: %s, msg: %s", resp.Code, resp.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicdata
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linstohu/nexapi/okx/publicdata/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+func (p *PublicDataClient) GetFundingRate(ctx context.Context, param types.InstIdParam) (*types.GetFundingRateResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/public/funding-rate",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFundingRateResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetFundingRateHistory(ctx context.Context, param types.GetFundingRateHistoryParam) (*types.GetFundingRateHistoryResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/public/funding-rate-history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFundingRateHistoryResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetOpenInterest(ctx context.Context, param types.GetOpenInterestParam) (*types.GetOpenInterestResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/public/open-interest",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOpenInterestResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetPriceLimit(ctx context.Context, param types.InstIdParam) (*types.GetPriceLimitResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/public/price-limit",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPriceLimitResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetEstimatedPrice(ctx context.Context, param types.InstIdParam) (*types.GetEstimatedPriceResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/public/estimated-price",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetEstimatedPriceResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetOptionSummary(ctx context.Context, param types.GetOptionSummaryParam) (*types.GetOptionSummaryResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/public/opt-summary",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOptionSummaryResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetUnderlying(ctx context.Context, param types.GetUnderlyingParam) (*types.GetUnderlyingResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/public/underlying",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetUnderlyingResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetInsuranceFund(ctx context.Context, param types.GetInsuranceFundParam) (*types.GetInsuranceFundResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/public/insurance-fund",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetInsuranceFundResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
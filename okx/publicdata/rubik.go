@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicdata
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linstohu/nexapi/okx/publicdata/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+// GetSupportCoin returns currencies supported by the trading statistics endpoints
+func (p *PublicDataClient) GetSupportCoin(ctx context.Context) (*types.GetSupportCoinResp, error) {
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/rubik/stat/trading-data/support-coin",
+		Method:  http.MethodGet,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetSupportCoinResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetTakerVolume(ctx context.Context, param types.GetTakerVolumeParam) (*types.GetTakerVolumeResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/rubik/stat/taker-volume",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetTakerVolumeResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetMarginLendingRatio(ctx context.Context, param types.RubikStatParam) (*types.GetRatioResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/rubik/stat/margin/loan-ratio",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetRatioResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetLongShortRatio(ctx context.Context, param types.RubikStatParam) (*types.GetRatioResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/rubik/stat/contracts/long-short-account-ratio",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetRatioResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetContractsOpenInterestVolume(ctx context.Context, param types.RubikStatParam) (*types.GetOpenInterestVolumeResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/rubik/stat/contracts/open-interest-volume",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOpenInterestVolumeResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetOptionsOpenInterestVolume(ctx context.Context, param types.GetOptionStatParam) (*types.GetOpenInterestVolumeResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/rubik/stat/option/open-interest-volume",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOpenInterestVolumeResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (p *PublicDataClient) GetPutCallRatio(ctx context.Context, param types.GetOptionStatParam) (*types.GetOpenInterestVolumeRatioResp, error) {
+	err := p.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   p.GetDebug(),
+		BaseURL: p.GetBaseURL(),
+		Path:    "/api/v5/rubik/stat/option/open-interest-volume-ratio",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := p.GenPubHeaders()
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := p.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOpenInterestVolumeRatioResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
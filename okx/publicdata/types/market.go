@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	okxtypes "github.com/linstohu/nexapi/okx/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+type GetOrderBookParam struct {
+	InstId string `url:"instId" validate:"required"`
+	// max 400 for books, max 5000 for books-full
+	Sz int `url:"sz,omitempty" validate:"omitempty,max=5000"`
+}
+
+type GetOrderBookResp struct {
+	okxutils.Response
+	Data []*OrderBook `json:"data"`
+}
+
+// OrderBook, each level is [price, size, deprecated, number of orders]
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-get-order-book
+type OrderBook struct {
+	Asks [][]string `json:"asks"`
+	Bids [][]string `json:"bids"`
+	Ts   string     `json:"ts"`
+}
+
+// GetCandlesParam is used by all candlestick endpoints, After and Before are timestamps in milliseconds
+type GetCandlesParam struct {
+	InstId string `url:"instId" validate:"required"`
+	Bar    string `url:"bar,omitempty" validate:"omitempty,oneof=3M 1M 1W 1D 2D 3D 5D 12H 6H 4H 2H 1H 30m 15m 5m 3m 1m 1s 3Mutc 1Mutc 1Wutc 1Dutc 2Dutc 3Dutc 5Dutc 12Hutc 6Hutc"`
+	After  string `url:"after,omitempty"`
+	Before string `url:"before,omitempty"`
+	Limit  int    `url:"limit,omitempty" validate:"omitempty,max=300"`
+}
+
+type GetCandlesResp struct {
+	okxutils.Response
+	Data []*Candle `json:"data"`
+}
+
+// Candle is returned by candlestick endpoints
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-get-candlesticks
+type Candle = okxtypes.Candle
+
+type GetPriceCandlesResp struct {
+	okxutils.Response
+	Data []*PriceCandle `json:"data"`
+}
+
+// PriceCandle is returned by index and mark price candlestick endpoints
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-index-candlesticks
+type PriceCandle = okxtypes.PriceCandle
+
+type GetTradesParam struct {
+	InstId string `url:"instId" validate:"required"`
+	Limit  int    `url:"limit,omitempty" validate:"omitempty,max=500"`
+}
+
+// GetHistoryTradesParam pages by trade id when Type is 1 (default), by timestamp when Type is 2
+type GetHistoryTradesParam struct {
+	InstId string `url:"instId" validate:"required"`
+	Type   string `url:"type,omitempty" validate:"omitempty,oneof=1 2"`
+	After  string `url:"after,omitempty"`
+	Before string `url:"before,omitempty"`
+	Limit  int    `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetTradesResp struct {
+	okxutils.Response
+	Data []*Trade `json:"data"`
+}
+
+// Trade
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-get-trades
+type Trade struct {
+	InstId  string `json:"instId"`
+	TradeId string `json:"tradeId"`
+	Px      string `json:"px"`
+	Sz      string `json:"sz"`
+	Side    string `json:"side"`
+	Ts      string `json:"ts"`
+}
+
+type GetIndexTickersParam struct {
+	QuoteCcy string `url:"quoteCcy,omitempty" validate:"required_without=InstId"`
+	InstId   string `url:"instId,omitempty"`
+}
+
+type GetIndexTickersResp struct {
+	okxutils.Response
+	Data []*IndexTicker `json:"data"`
+}
+
+// IndexTicker
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-index-tickers
+type IndexTicker struct {
+	InstId  string `json:"instId"`
+	IdxPx   string `json:"idxPx"`
+	High24H string `json:"high24h"`
+	Low24H  string `json:"low24h"`
+	Open24H string `json:"open24h"`
+	SodUtc0 string `json:"sodUtc0"`
+	SodUtc8 string `json:"sodUtc8"`
+	Ts      string `json:"ts"`
+}
@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+// InstIdParam is used by endpoints which only take an instrument id
+type InstIdParam struct {
+	InstId string `url:"instId" validate:"required"`
+}
+
+type GetFundingRateResp struct {
+	okxutils.Response
+	Data []*FundingRate `json:"data"`
+}
+
+// FundingRate
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-funding-rate
+type FundingRate struct {
+	InstType        string `json:"instType"`
+	InstId          string `json:"instId"`
+	Method          string `json:"method"`
+	FundingRate     string `json:"fundingRate"`
+	NextFundingRate string `json:"nextFundingRate"`
+	FundingTime     string `json:"fundingTime"`
+	NextFundingTime string `json:"nextFundingTime"`
+	MinFundingRate  string `json:"minFundingRate"`
+	MaxFundingRate  string `json:"maxFundingRate"`
+	SettState       string `json:"settState"`
+	SettFundingRate string `json:"settFundingRate"`
+	Premium         string `json:"premium"`
+	Ts              string `json:"ts"`
+}
+
+// GetFundingRateHistoryParam, After and Before are funding times in milliseconds
+type GetFundingRateHistoryParam struct {
+	InstId string `url:"instId" validate:"required"`
+	After  string `url:"after,omitempty"`
+	Before string `url:"before,omitempty"`
+	Limit  int    `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetFundingRateHistoryResp struct {
+	okxutils.Response
+	Data []*FundingRateHistory `json:"data"`
+}
+
+// FundingRateHistory
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-funding-rate-history
+type FundingRateHistory struct {
+	InstType     string `json:"instType"`
+	InstId       string `json:"instId"`
+	FormulaType  string `json:"formulaType"`
+	FundingRate  string `json:"fundingRate"`
+	RealizedRate string `json:"realizedRate"`
+	FundingTime  string `json:"fundingTime"`
+	Method       string `json:"method"`
+}
+
+type GetOpenInterestParam struct {
+	InstType   okxutils.InstrumentType `url:"instType" validate:"required,oneof=SWAP FUTURES OPTION"`
+	Uly        string                  `url:"uly,omitempty"`
+	InstFamily string                  `url:"instFamily,omitempty"`
+	InstId     string                  `url:"instId,omitempty"`
+}
+
+type GetOpenInterestResp struct {
+	okxutils.Response
+	Data []*OpenInterest `json:"data"`
+}
+
+// OpenInterest
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-open-interest
+type OpenInterest struct {
+	InstType string `json:"instType"`
+	InstId   string `json:"instId"`
+	Oi       string `json:"oi"`
+	OiCcy    string `json:"oiCcy"`
+	OiUsd    string `json:"oiUsd"`
+	Ts       string `json:"ts"`
+}
+
+type GetPriceLimitResp struct {
+	okxutils.Response
+	Data []*PriceLimit `json:"data"`
+}
+
+// PriceLimit
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-limit-price
+type PriceLimit struct {
+	InstType string `json:"instType"`
+	InstId   string `json:"instId"`
+	BuyLmt   string `json:"buyLmt"`
+	SellLmt  string `json:"sellLmt"`
+	Enabled  bool   `json:"enabled"`
+	Ts       string `json:"ts"`
+}
+
+type GetEstimatedPriceResp struct {
+	okxutils.Response
+	Data []*EstimatedPrice `json:"data"`
+}
+
+// EstimatedPrice is only available within one hour before delivery or exercise
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-estimated-delivery-exercise-price
+type EstimatedPrice struct {
+	InstType string `json:"instType"`
+	InstId   string `json:"instId"`
+	SettlePx string `json:"settlePx"`
+	Ts       string `json:"ts"`
+}
+
+type GetOptionSummaryParam struct {
+	Uly        string `url:"uly,omitempty" validate:"required_without=InstFamily"`
+	InstFamily string `url:"instFamily,omitempty"`
+	// format: YYMMDD
+	ExpTime string `url:"expTime,omitempty"`
+}
+
+type GetOptionSummaryResp struct {
+	okxutils.Response
+	Data []*OptionSummary `json:"data"`
+}
+
+// OptionSummary
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-option-market-data
+type OptionSummary struct {
+	InstType string `json:"instType"`
+	InstId   string `json:"instId"`
+	Uly      string `json:"uly"`
+	Delta    string `json:"delta"`
+	Gamma    string `json:"gamma"`
+	Vega     string `json:"vega"`
+	Theta    string `json:"theta"`
+	DeltaBS  string `json:"deltaBS"`
+	GammaBS  string `json:"gammaBS"`
+	VegaBS   string `json:"vegaBS"`
+	ThetaBS  string `json:"thetaBS"`
+	Lever    string `json:"lever"`
+	MarkVol  string `json:"markVol"`
+	BidVol   string `json:"bidVol"`
+	AskVol   string `json:"askVol"`
+	RealVol  string `json:"realVol"`
+	VolLv    string `json:"volLv"`
+	FwdPx    string `json:"fwdPx"`
+	Ts       string `json:"ts"`
+}
+
+type GetUnderlyingParam struct {
+	InstType okxutils.InstrumentType `url:"instType" validate:"required,oneof=SWAP FUTURES OPTION"`
+}
+
+type GetUnderlyingResp struct {
+	okxutils.Response
+	Data [][]string `json:"data"`
+}
+
+// GetInsuranceFundParam, After and Before are timestamps in milliseconds
+type GetInsuranceFundParam struct {
+	InstType   okxutils.InstrumentType `url:"instType" validate:"required,oneof=MARGIN SWAP FUTURES OPTION"`
+	Type       string                  `url:"type,omitempty" validate:"omitempty,oneof=regular_update liquidation_balance_deposit bankruptcy_loss platform_revenue adl"`
+	Uly        string                  `url:"uly,omitempty"`
+	InstFamily string                  `url:"instFamily,omitempty"`
+	Ccy        string                  `url:"ccy,omitempty"`
+	After      string                  `url:"after,omitempty"`
+	Before     string                  `url:"before,omitempty"`
+	Limit      int                     `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetInsuranceFundResp struct {
+	okxutils.Response
+	Data []*InsuranceFund `json:"data"`
+}
+
+// InsuranceFund
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-insurance-fund
+type InsuranceFund struct {
+	Total      string                 `json:"total"`
+	InstFamily string                 `json:"instFamily"`
+	InstType   string                 `json:"instType"`
+	Details    []*InsuranceFundDetail `json:"details"`
+}
+
+type InsuranceFundDetail struct {
+	Balance  string `json:"balance"`
+	Amt      string `json:"amt"`
+	Ccy      string `json:"ccy"`
+	Type     string `json:"type"`
+	MaxBal   string `json:"maxBal"`
+	MaxBalTs string `json:"maxBalTs"`
+	DecRate  string `json:"decRate"`
+	AdlType  string `json:"adlType"`
+	Ts       string `json:"ts"`
+}
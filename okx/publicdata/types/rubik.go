@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+type GetSupportCoinResp struct {
+	okxutils.Response
+	Data struct {
+		Contract []string `json:"contract"`
+		Option   []string `json:"option"`
+		Spot     []string `json:"spot"`
+	} `json:"data"`
+}
+
+// RubikStatParam is used by the trading statistics endpoints, Begin and End are timestamps in milliseconds
+type RubikStatParam struct {
+	Ccy    string `url:"ccy" validate:"required"`
+	Begin  string `url:"begin,omitempty"`
+	End    string `url:"end,omitempty"`
+	Period string `url:"period,omitempty" validate:"omitempty,oneof=5m 1H 1D"`
+}
+
+type GetTakerVolumeParam struct {
+	Ccy      string                  `url:"ccy" validate:"required"`
+	InstType okxutils.InstrumentType `url:"instType" validate:"required,oneof=SPOT CONTRACTS"`
+	Begin    string                  `url:"begin,omitempty"`
+	End      string                  `url:"end,omitempty"`
+	Period   string                  `url:"period,omitempty" validate:"omitempty,oneof=5m 1H 1D"`
+}
+
+type GetTakerVolumeResp struct {
+	okxutils.Response
+	Data []*TakerVolume `json:"data"`
+}
+
+// TakerVolume is returned as [ts, sellVol, buyVol]
+// doc: https://www.okx.com/docs-v5/en/#trading-statistics-rest-api-get-taker-volume
+type TakerVolume struct {
+	Ts      string
+	SellVol string
+	BuyVol  string
+}
+
+func (t *TakerVolume) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalStat(data, 3)
+	if err != nil {
+		return err
+	}
+
+	t.Ts, t.SellVol, t.BuyVol = v[0], v[1], v[2]
+
+	return nil
+}
+
+type GetRatioResp struct {
+	okxutils.Response
+	Data []*Ratio `json:"data"`
+}
+
+// Ratio is returned by the margin lending ratio and long/short account ratio endpoints as [ts, ratio]
+// doc: https://www.okx.com/docs-v5/en/#trading-statistics-rest-api-get-margin-lending-ratio
+type Ratio struct {
+	Ts    string
+	Ratio string
+}
+
+func (r *Ratio) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalStat(data, 2)
+	if err != nil {
+		return err
+	}
+
+	r.Ts, r.Ratio = v[0], v[1]
+
+	return nil
+}
+
+type GetOpenInterestVolumeResp struct {
+	okxutils.Response
+	Data []*OpenInterestVolume `json:"data"`
+}
+
+// OpenInterestVolume is returned as [ts, oi, vol]
+// doc: https://www.okx.com/docs-v5/en/#trading-statistics-rest-api-get-contracts-open-interest-and-volume
+type OpenInterestVolume struct {
+	Ts  string
+	Oi  string
+	Vol string
+}
+
+func (o *OpenInterestVolume) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalStat(data, 3)
+	if err != nil {
+		return err
+	}
+
+	o.Ts, o.Oi, o.Vol = v[0], v[1], v[2]
+
+	return nil
+}
+
+type GetOptionStatParam struct {
+	Ccy    string `url:"ccy" validate:"required"`
+	Period string `url:"period,omitempty" validate:"omitempty,oneof=8H 1D"`
+}
+
+type GetOpenInterestVolumeRatioResp struct {
+	okxutils.Response
+	Data []*OpenInterestVolumeRatio `json:"data"`
+}
+
+// OpenInterestVolumeRatio is returned as [ts, oiRatio, volRatio]
+// doc: https://www.okx.com/docs-v5/en/#trading-statistics-rest-api-get-put-call-ratio
+type OpenInterestVolumeRatio struct {
+	Ts       string
+	OiRatio  string
+	VolRatio string
+}
+
+func (o *OpenInterestVolumeRatio) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalStat(data, 3)
+	if err != nil {
+		return err
+	}
+
+	o.Ts, o.OiRatio, o.VolRatio = v[0], v[1], v[2]
+
+	return nil
+}
+
+func unmarshalStat(data []byte, n int) ([]string, error) {
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	if len(v) < n {
+		return nil, fmt.Errorf("invalid trading statistics: %s", string(data))
+	}
+
+	return v, nil
+}
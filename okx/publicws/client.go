@@ -0,0 +1,404 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/gorilla/websocket"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+	"github.com/linstohu/nexapi/utils"
+	cmap "github.com/orcaman/concurrent-map/v2"
+)
+
+type PublicWsClient struct {
+	baseURL string
+	// debug mode
+	debug bool
+	// logger
+	logger *slog.Logger
+
+	stopCtx context.Context
+	cancel  context.CancelFunc
+
+	conn        *websocket.Conn
+	mu          sync.RWMutex
+	isConnected bool
+
+	autoReconnect bool
+	heartCancel   chan struct{}
+	disconnect    chan struct{}
+
+	sending       sync.Mutex
+	subscriptions cmap.ConcurrentMap[string, *okxutils.Arg]
+
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
+}
+
+type PublicWsClientCfg struct {
+	Debug bool
+	// okxutils.PublicWsURL, or okxutils.BusinessWsURL for candlestick channels
+	BaseURL       string `validate:"required"`
+	AutoReconnect bool   `validate:"required"`
+	// Demo connects to the demo trading equivalent of BaseURL
+	Demo bool
+
+	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
+}
+
+func NewPublicWsClient(cfg *PublicWsClientCfg) (*PublicWsClient, error) {
+	if err := validator.New().Struct(cfg); err != nil {
+		return nil, err
+	}
+
+	cli := &PublicWsClient{
+		debug:   cfg.Debug,
+		baseURL: okxutils.GetWsURL(cfg.BaseURL, cfg.Demo),
+		logger:  cfg.Logger,
+
+		autoReconnect: cfg.AutoReconnect,
+
+		subscriptions: cmap.New[*okxutils.Arg](),
+		emitter:       utils.NewEmitter(),
+	}
+
+	if cli.logger == nil {
+		cli.logger = slog.Default()
+	}
+
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
+	return cli, nil
+}
+
+func (p *PublicWsClient) Open() error {
+	if p.stopCtx != nil {
+		return fmt.Errorf("%s: ws is already open", logPrefix)
+	}
+
+	p.stopCtx, p.cancel = context.WithCancel(context.Background())
+
+	err := p.start()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *PublicWsClient) Close() error {
+	if p.stopCtx == nil {
+		return fmt.Errorf("%s: ws is not open", logPrefix)
+	}
+
+	p.cancel()
+
+	if p.dispatcher != nil {
+		p.dispatcher.Close()
+	}
+
+	return nil
+}
+
+func (p *PublicWsClient) start() error {
+	p.conn = nil
+	p.setIsConnected(false)
+	p.heartCancel = make(chan struct{})
+	p.disconnect = make(chan struct{})
+
+	for i := 0; i < MaxTryTimes; i++ {
+		conn, _, err := p.connect()
+		if err != nil {
+			p.logger.Info(fmt.Sprintf("%s: connect error, times(%v), error: %s", logPrefix, i, err.Error()))
+			tm := (i + 1) * 5
+			time.Sleep(time.Duration(tm) * time.Second)
+			continue
+		}
+		p.conn = conn
+		break
+	}
+	if p.conn == nil {
+		return errors.New("connect failed")
+	}
+
+	p.logger.Info(fmt.Sprintf("%s: connect success, base_url: %s", logPrefix, p.baseURL))
+
+	p.setIsConnected(true)
+
+	p.resubscribe()
+
+	if p.autoReconnect {
+		go p.reconnect()
+	}
+
+	go p.heartbeat()
+
+	go p.readMessages()
+
+	return nil
+}
+
+func (p *PublicWsClient) connect() (*websocket.Conn, *http.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, p.baseURL, nil)
+	if err == nil {
+		conn.SetReadLimit(32768 * 64)
+	}
+
+	return conn, resp, err
+}
+
+func (p *PublicWsClient) reconnect() {
+	<-p.disconnect
+
+	p.setIsConnected(false)
+
+	close(p.heartCancel)
+
+	time.Sleep(1 * time.Second)
+
+	select {
+	case <-p.stopCtx.Done():
+		p.logger.Info(fmt.Sprintf("%s: reconnection exits", logPrefix))
+		return
+	default:
+		p.logger.Info(fmt.Sprintf("%s: try to reconnect...", logPrefix))
+		p.start()
+	}
+}
+
+// close closes the websocket connection
+func (p *PublicWsClient) close() error {
+	close(p.disconnect)
+
+	err := p.conn.Close()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setIsConnected sets state for isConnected
+func (p *PublicWsClient) setIsConnected(state bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.isConnected = state
+}
+
+// IsConnected returns the WebSocket connection state
+func (p *PublicWsClient) IsConnected() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.isConnected
+}
+
+// heartbeat sends a text ping every HeartbeatInterval seconds to keep alive
+func (p *PublicWsClient) heartbeat() {
+	t := time.NewTicker(HeartbeatInterval * time.Second)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			p.ping()
+		case <-p.heartCancel:
+			return
+		}
+	}
+}
+
+func (p *PublicWsClient) readMessages() {
+	for {
+		select {
+		case <-p.stopCtx.Done():
+			p.logger.Info(fmt.Sprintf("%s: ready to close...", logPrefix))
+
+			if err := p.close(); err != nil {
+				p.logger.Error(fmt.Sprintf("%s: connection closed error, %s", logPrefix, err.Error()))
+				return
+			}
+
+			p.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
+			return
+		default:
+			_, buf, err := p.conn.ReadMessage()
+			if err != nil {
+				p.logger.Info(fmt.Sprintf("%s: read message error, %s", logPrefix, err))
+				p.logger.Info(fmt.Sprintf("%s: ready to close...", logPrefix))
+
+				if err := p.close(); err != nil {
+					p.logger.Error(fmt.Sprintf("%s: connection closed error, %s", logPrefix, err.Error()))
+					return
+				}
+
+				p.logger.Info(fmt.Sprintf("%s: connection closed success", logPrefix))
+				return
+			}
+
+			if string(buf) == okxutils.WsPong {
+				continue
+			}
+
+			var msg okxutils.WsMessage
+			if err := json.Unmarshal(buf, &msg); err != nil {
+				p.logger.Info(fmt.Sprintf("%s: read object error, %s", logPrefix, err))
+				continue
+			}
+
+			switch {
+			case msg.Event == okxutils.WsError:
+				p.logger.Error(fmt.Sprintf("%s: websocket error, code: %s, msg: %s", logPrefix, msg.Code, msg.Msg))
+			case msg.Event != "":
+				if p.debug {
+					p.logger.Info(fmt.Sprintf("%s: event: %s, conn_id: %s", logPrefix, msg.Event, msg.ConnId))
+				}
+			case msg.Arg != nil:
+				err := p.handle(&msg)
+				if err != nil {
+					p.logger.Info(fmt.Sprintf("%s: handle message error: %s", logPrefix, err.Error()))
+				}
+			}
+		}
+	}
+}
+
+func (p *PublicWsClient) resubscribe() error {
+	args := p.subscriptions.Items()
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	req := make([]*okxutils.Arg, 0, len(args))
+	for _, v := range args {
+		req = append(req, v)
+	}
+
+	return p.send(&okxutils.WsRequest{
+		Op:   okxutils.WsSubscribe,
+		Args: req,
+	})
+}
+
+func (p *PublicWsClient) subscribe(topics []string) error {
+	args := make([]*okxutils.Arg, 0)
+	seen := make(map[string]bool)
+
+	for _, topic := range topics {
+		arg, err := okxutils.ParseTopic(topic)
+		if err != nil {
+			return err
+		}
+
+		// topics are keyed by their canonical form, whatever order the fields were written in
+		topic = arg.Topic()
+		if seen[topic] || p.subscriptions.Has(topic) {
+			continue
+		}
+		seen[topic] = true
+
+		args = append(args, arg)
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	// do subscription
+	err := p.send(&okxutils.WsRequest{
+		Op:   okxutils.WsSubscribe,
+		Args: args,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range args {
+		p.subscriptions.Set(v.Topic(), v)
+	}
+
+	return nil
+}
+
+func (p *PublicWsClient) unsubscribe(topics []string) error {
+	args := make([]*okxutils.Arg, 0, len(topics))
+
+	for _, topic := range topics {
+		arg, err := okxutils.ParseTopic(topic)
+		if err != nil {
+			return err
+		}
+
+		args = append(args, arg)
+	}
+
+	err := p.send(&okxutils.WsRequest{
+		Op:   okxutils.WsUnsubscribe,
+		Args: args,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range args {
+		p.subscriptions.Remove(v.Topic())
+	}
+
+	return nil
+}
+
+func (p *PublicWsClient) send(req *okxutils.WsRequest) error {
+	p.sending.Lock()
+	defer p.sending.Unlock()
+
+	if !p.IsConnected() {
+		return errors.New("connection is closed")
+	}
+
+	return p.conn.WriteJSON(req)
+}
+
+func (p *PublicWsClient) ping() error {
+	p.sending.Lock()
+	defer p.sending.Unlock()
+
+	if !p.IsConnected() {
+		return errors.New("connection is closed")
+	}
+
+	return p.conn.WriteMessage(websocket.TextMessage, []byte(okxutils.WsPing))
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/linstohu/nexapi/okx/publicws/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNewPublicWsClient(t *testing.T, url string) *PublicWsClient {
+	cli, err := NewPublicWsClient(&PublicWsClientCfg{
+		Debug:         true,
+		BaseURL:       url,
+		AutoReconnect: true,
+	})
+
+	if err != nil {
+		t.Fatalf("Could not create websocket client, %s", err)
+	}
+
+	err = cli.Open()
+	if err != nil {
+		t.Fatalf("Could not open websocket client, %s", err)
+	}
+
+	return cli
+}
+
+func TestSubscribeTickers(t *testing.T) {
+	cli := testNewPublicWsClient(t, okxutils.PublicWsURL)
+	defer cli.Close()
+
+	topic, err := cli.GetTickersTopic("BTC-USDT")
+	assert.Nil(t, err)
+
+	cli.OnTicker(topic, func(e *types.Ticker) {
+		fmt.Printf("Topic: %s, Last: %v, BidPx: %v, AskPx: %v\n",
+			topic, e.Last, e.BidPx, e.AskPx)
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+
+	err = cli.UnSubscribe([]string{topic})
+	assert.Nil(t, err)
+}
+
+func TestSubscribeOrderBook(t *testing.T) {
+	cli := testNewPublicWsClient(t, okxutils.PublicWsURL)
+	defer cli.Close()
+
+	topic, err := cli.GetOrderBookTopic(&OrderBookTopicParam{
+		Channel: "books5",
+		InstId:  "BTC-USDT-SWAP",
+	})
+	assert.Nil(t, err)
+
+	cli.OnOrderBook(topic, func(e *types.OrderBook) {
+		fmt.Printf("Topic: %s, Bids: %v, Asks: %v\n", topic, len(e.Bids), len(e.Asks))
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+}
+
+func TestSubscribeCandle(t *testing.T) {
+	cli := testNewPublicWsClient(t, okxutils.BusinessWsURL)
+	defer cli.Close()
+
+	topic, err := cli.GetCandleTopic(&CandleTopicParam{
+		Bar:    "1m",
+		InstId: "BTC-USDT",
+	})
+	assert.Nil(t, err)
+
+	cli.OnCandle(topic, func(e *types.Candle) {
+		fmt.Printf("Topic: %s, Open: %v, Close: %v, Low: %v, High: %v\n",
+			topic, e.Open, e.Close, e.Low, e.High)
+	})
+
+	err = cli.Subscribe([]string{topic})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Second)
+}
+
+func TestSubscribeCanonicalTopic(t *testing.T) {
+	requests := make(chan *okxutils.WsRequest, 8)
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var req okxutils.WsRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			requests <- &req
+		}
+	}))
+	defer srv.Close()
+
+	cli := testNewPublicWsClient(t, "ws"+strings.TrimPrefix(srv.URL, "http"))
+	defer cli.Close()
+
+	topic := "tickers:instType=SPOT:instId=BTC-USDT"
+	reordered := "tickers:instId=BTC-USDT:instType=SPOT"
+
+	err := cli.Subscribe([]string{reordered, topic})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{topic}, cli.subscriptions.Keys())
+
+	req := <-requests
+	assert.Equal(t, okxutils.WsSubscribe, req.Op)
+	assert.Len(t, req.Args, 1)
+
+	// already subscribed under its canonical topic, nothing is sent
+	err = cli.Subscribe([]string{reordered})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{topic}, cli.subscriptions.Keys())
+
+	err = cli.UnSubscribe([]string{reordered})
+	assert.Nil(t, err)
+	assert.Empty(t, cli.subscriptions.Keys())
+
+	req = <-requests
+	assert.Equal(t, okxutils.WsUnsubscribe, req.Op)
+	assert.Len(t, req.Args, 1)
+	assert.Empty(t, requests)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicws
+
+import (
+	"github.com/linstohu/nexapi/okx/publicws/types"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type Listener = utils.Listener
+
+// AddListener registers listener for event, the returned func removes it.
+func (p *PublicWsClient) AddListener(event string, listener Listener) func() {
+	return p.emitter.On(event, listener)
+}
+
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (p *PublicWsClient) RemoveListener(event string, listener Listener) {
+	p.emitter.Off(event, listener)
+}
+
+func (p *PublicWsClient) GetListeners(event string, argument any) {
+	if p.dispatcher != nil {
+		p.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	p.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (p *PublicWsClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if p.dispatcher == nil {
+		return nil
+	}
+
+	return p.dispatcher.Metrics()
+}
+
+func (p *PublicWsClient) OnTicker(topic string, fn func(*types.Ticker)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnOrderBook(topic string, fn func(*types.OrderBook)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnTrade(topic string, fn func(*types.Trade)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnCandle(topic string, fn func(*types.Candle)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnPriceCandle(topic string, fn func(*types.PriceCandle)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnMarkPrice(topic string, fn func(*types.MarkPrice)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnIndexTicker(topic string, fn func(*types.IndexTicker)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnFundingRate(topic string, fn func(*types.FundingRate)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnOpenInterest(topic string, fn func(*types.OpenInterest)) func() {
+	return utils.On(p, topic, fn)
+}
+
+func (p *PublicWsClient) OnLiquidationOrder(topic string, fn func(*types.LiquidationOrder)) func() {
+	return utils.On(p, topic, fn)
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/linstohu/nexapi/okx/publicws/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+func (p *PublicWsClient) Subscribe(topics []string) error {
+	return p.subscribe(topics)
+}
+
+func (p *PublicWsClient) UnSubscribe(topics []string) error {
+	return p.unsubscribe(topics)
+}
+
+func (p *PublicWsClient) handle(msg *okxutils.WsMessage) error {
+	topic := msg.Arg.Topic()
+
+	if p.debug {
+		p.logger.Info(fmt.Sprintf("%s: subscribed message, topic: %s", logPrefix, topic))
+	}
+
+	channel := msg.Arg.Channel
+
+	switch {
+	case channel == "tickers":
+		var data []*types.Ticker
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case strings.HasPrefix(channel, "books") || channel == "bbo-tbt":
+		var data []*types.OrderBook
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			v.Action = msg.Action
+			if v.InstId == "" {
+				v.InstId = msg.Arg.InstId
+			}
+			p.GetListeners(topic, v)
+		}
+	case channel == "trades":
+		var data []*types.Trade
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case strings.HasPrefix(channel, "candle"):
+		var data []*types.Candle
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case strings.HasPrefix(channel, "mark-price-candle") || strings.HasPrefix(channel, "index-candle"):
+		var data []*types.PriceCandle
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case channel == "mark-price":
+		var data []*types.MarkPrice
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case channel == "index-tickers":
+		var data []*types.IndexTicker
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case channel == "funding-rate":
+		var data []*types.FundingRate
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case channel == "open-interest":
+		var data []*types.OpenInterest
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	case channel == "liquidation-orders":
+		var data []*types.LiquidationOrder
+		err := json.Unmarshal(msg.Data, &data)
+		if err != nil {
+			return err
+		}
+		for _, v := range data {
+			p.GetListeners(topic, v)
+		}
+	default:
+		return fmt.Errorf("unknown message, topic: %s", topic)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publicws
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+func (p *PublicWsClient) GetTickersTopic(instId string) (string, error) {
+	if instId == "" {
+		return "", fmt.Errorf("the instId field must be provided")
+	}
+
+	arg := okxutils.Arg{Channel: "tickers", InstId: instId}
+
+	return arg.Topic(), nil
+}
+
+type OrderBookTopicParam struct {
+	// books-l2-tbt and books50-l2-tbt need a login as VIP user
+	Channel string `validate:"required,oneof=books books5 bbo-tbt books-l2-tbt books50-l2-tbt"`
+	InstId  string `validate:"required"`
+}
+
+func (p *PublicWsClient) GetOrderBookTopic(params *OrderBookTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	arg := okxutils.Arg{Channel: params.Channel, InstId: params.InstId}
+
+	return arg.Topic(), nil
+}
+
+func (p *PublicWsClient) GetTradesTopic(instId string) (string, error) {
+	if instId == "" {
+		return "", fmt.Errorf("the instId field must be provided")
+	}
+
+	arg := okxutils.Arg{Channel: "trades", InstId: instId}
+
+	return arg.Topic(), nil
+}
+
+type CandleTopicParam struct {
+	Bar    string `validate:"required,oneof=3M 1M 1W 1D 2D 3D 5D 12H 6H 4H 2H 1H 30m 15m 5m 3m 1m 1s 3Mutc 1Mutc 1Wutc 1Dutc 2Dutc 3Dutc 5Dutc 12Hutc 6Hutc"`
+	InstId string `validate:"required"`
+}
+
+// GetCandleTopic needs a client connected to okxutils.BusinessWsURL
+func (p *PublicWsClient) GetCandleTopic(params *CandleTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	arg := okxutils.Arg{Channel: "candle" + params.Bar, InstId: params.InstId}
+
+	return arg.Topic(), nil
+}
+
+// GetMarkPriceCandleTopic needs a client connected to okxutils.BusinessWsURL
+func (p *PublicWsClient) GetMarkPriceCandleTopic(params *CandleTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	arg := okxutils.Arg{Channel: "mark-price-candle" + params.Bar, InstId: params.InstId}
+
+	return arg.Topic(), nil
+}
+
+// GetIndexCandleTopic needs a client connected to okxutils.BusinessWsURL
+func (p *PublicWsClient) GetIndexCandleTopic(params *CandleTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	arg := okxutils.Arg{Channel: "index-candle" + params.Bar, InstId: params.InstId}
+
+	return arg.Topic(), nil
+}
+
+func (p *PublicWsClient) GetMarkPriceTopic(instId string) (string, error) {
+	if instId == "" {
+		return "", fmt.Errorf("the instId field must be provided")
+	}
+
+	arg := okxutils.Arg{Channel: "mark-price", InstId: instId}
+
+	return arg.Topic(), nil
+}
+
+// GetIndexTickersTopic takes an index, e.g. BTC-USDT
+func (p *PublicWsClient) GetIndexTickersTopic(instId string) (string, error) {
+	if instId == "" {
+		return "", fmt.Errorf("the instId field must be provided")
+	}
+
+	arg := okxutils.Arg{Channel: "index-tickers", InstId: instId}
+
+	return arg.Topic(), nil
+}
+
+func (p *PublicWsClient) GetFundingRateTopic(instId string) (string, error) {
+	if instId == "" {
+		return "", fmt.Errorf("the instId field must be provided")
+	}
+
+	arg := okxutils.Arg{Channel: "funding-rate", InstId: instId}
+
+	return arg.Topic(), nil
+}
+
+func (p *PublicWsClient) GetOpenInterestTopic(instId string) (string, error) {
+	if instId == "" {
+		return "", fmt.Errorf("the instId field must be provided")
+	}
+
+	arg := okxutils.Arg{Channel: "open-interest", InstId: instId}
+
+	return arg.Topic(), nil
+}
+
+type LiquidationOrdersTopicParam struct {
+	InstType okxutils.InstrumentType `validate:"required,oneof=MARGIN SWAP FUTURES OPTION"`
+}
+
+func (p *PublicWsClient) GetLiquidationOrdersTopic(params *LiquidationOrdersTopicParam) (string, error) {
+	err := validator.New().Struct(params)
+	if err != nil {
+		return "", err
+	}
+
+	arg := okxutils.Arg{Channel: "liquidation-orders", InstType: params.InstType}
+
+	return arg.Topic(), nil
+}
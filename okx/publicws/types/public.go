@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	okxtypes "github.com/linstohu/nexapi/okx/types"
+)
+
+// Ticker
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-ws-tickers-channel
+type Ticker struct {
+	InstType  string `json:"instType"`
+	InstId    string `json:"instId"`
+	Last      string `json:"last"`
+	LastSz    string `json:"lastSz"`
+	AskPx     string `json:"askPx"`
+	AskSz     string `json:"askSz"`
+	BidPx     string `json:"bidPx"`
+	BidSz     string `json:"bidSz"`
+	Open24H   string `json:"open24h"`
+	High24H   string `json:"high24h"`
+	Low24H    string `json:"low24h"`
+	SodUtc0   string `json:"sodUtc0"`
+	SodUtc8   string `json:"sodUtc8"`
+	VolCcy24H string `json:"volCcy24h"`
+	Vol24H    string `json:"vol24h"`
+	Ts        string `json:"ts"`
+}
+
+// OrderBook is pushed by books, books5, bbo-tbt, books-l2-tbt and books50-l2-tbt,
+// each level is [price, size, deprecated, number of orders].
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-ws-order-book-channel
+type OrderBook struct {
+	// Action is snapshot or update, only books, books-l2-tbt and books50-l2-tbt send increments
+	Action    string     `json:"-"`
+	InstId    string     `json:"instId"`
+	Asks      [][]string `json:"asks"`
+	Bids      [][]string `json:"bids"`
+	Ts        string     `json:"ts"`
+	Checksum  int64      `json:"checksum"`
+	SeqId     int64      `json:"seqId"`
+	PrevSeqId int64      `json:"prevSeqId"`
+}
+
+// Trade
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-ws-trades-channel
+type Trade struct {
+	InstId  string `json:"instId"`
+	TradeId string `json:"tradeId"`
+	Px      string `json:"px"`
+	Sz      string `json:"sz"`
+	Side    string `json:"side"`
+	Ts      string `json:"ts"`
+	Count   string `json:"count"`
+}
+
+// Candle is pushed by candlestick channels
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-ws-candlesticks-channel
+type Candle = okxtypes.Candle
+
+// PriceCandle is pushed by mark price and index candlestick channels
+// doc: https://www.okx.com/docs-v5/en/#public-data-websocket-mark-price-candlesticks-channel
+type PriceCandle = okxtypes.PriceCandle
+
+// MarkPrice
+// doc: https://www.okx.com/docs-v5/en/#public-data-websocket-mark-price-channel
+type MarkPrice struct {
+	InstType string `json:"instType"`
+	InstId   string `json:"instId"`
+	MarkPx   string `json:"markPx"`
+	Ts       string `json:"ts"`
+}
+
+// IndexTicker
+// doc: https://www.okx.com/docs-v5/en/#public-data-websocket-index-tickers-channel
+type IndexTicker struct {
+	InstId  string `json:"instId"`
+	IdxPx   string `json:"idxPx"`
+	Open24H string `json:"open24h"`
+	High24H string `json:"high24h"`
+	Low24H  string `json:"low24h"`
+	SodUtc0 string `json:"sodUtc0"`
+	SodUtc8 string `json:"sodUtc8"`
+	Ts      string `json:"ts"`
+}
+
+// FundingRate
+// doc: https://www.okx.com/docs-v5/en/#public-data-websocket-funding-rate-channel
+type FundingRate struct {
+	InstType        string `json:"instType"`
+	InstId          string `json:"instId"`
+	Method          string `json:"method"`
+	FundingRate     string `json:"fundingRate"`
+	NextFundingRate string `json:"nextFundingRate"`
+	FundingTime     string `json:"fundingTime"`
+	NextFundingTime string `json:"nextFundingTime"`
+	MinFundingRate  string `json:"minFundingRate"`
+	MaxFundingRate  string `json:"maxFundingRate"`
+	SettState       string `json:"settState"`
+	SettFundingRate string `json:"settFundingRate"`
+	Premium         string `json:"premium"`
+	Ts              string `json:"ts"`
+}
+
+// OpenInterest
+// doc: https://www.okx.com/docs-v5/en/#public-data-websocket-open-interest-channel
+type OpenInterest struct {
+	InstType string `json:"instType"`
+	InstId   string `json:"instId"`
+	Oi       string `json:"oi"`
+	OiCcy    string `json:"oiCcy"`
+	Ts       string `json:"ts"`
+}
+
+// LiquidationOrder
+// doc: https://www.okx.com/docs-v5/en/#public-data-websocket-liquidation-orders-channel
+type LiquidationOrder struct {
+	InstType   string `json:"instType"`
+	InstId     string `json:"instId"`
+	InstFamily string `json:"instFamily"`
+	Uly        string `json:"uly"`
+	Details    []struct {
+		Side    string `json:"side"`
+		PosSide string `json:"posSide"`
+		BkPx    string `json:"bkPx"`
+		Sz      string `json:"sz"`
+		BkLoss  string `json:"bkLoss"`
+		Ccy     string `json:"ccy"`
+		Ts      string `json:"ts"`
+	} `json:"details"`
+}
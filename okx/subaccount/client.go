@@ -0,0 +1,376 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package subaccount
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-playground/validator"
+	"github.com/linstohu/nexapi/okx/subaccount/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type SubAccountClient struct {
+	*okxutils.OKXRestClient
+
+	// validate struct fields
+	validate *validator.Validate
+}
+
+type SubAccountClientCfg struct {
+	BaseURL    string `validate:"required"`
+	Key        string `validate:"required"`
+	Secret     string `validate:"required"`
+	Passphrase string `validate:"required"`
+	Debug      bool
+	// Demo switches to demo trading
+	Demo bool
+	// Logger
+	Logger *slog.Logger
+}
+
+func NewSubAccountClient(cfg *SubAccountClientCfg) (*SubAccountClient, error) {
+	validator := validator.New()
+
+	err := validator.Struct(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := okxutils.NewOKXRestClient(&okxutils.OKXRestClientCfg{
+		Debug:      cfg.Debug,
+		Demo:       cfg.Demo,
+		Logger:     cfg.Logger,
+		BaseURL:    cfg.BaseURL,
+		Key:        cfg.Key,
+		Secret:     cfg.Secret,
+		Passphrase: cfg.Passphrase,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubAccountClient{
+		OKXRestClient: cli,
+		validate:      validator,
+	}, nil
+}
+
+func (s *SubAccountClient) GetSubAccounts(ctx context.Context, param types.GetSubAccountsParam) (*types.GetSubAccountsResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/users/subaccount/list",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetSubAccountsResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetTradingBalance returns the trading account balance of a sub-account
+func (s *SubAccountClient) GetTradingBalance(ctx context.Context, param types.SubAcctParam) (*types.GetTradingBalanceResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/account/subaccount/balances",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetTradingBalanceResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetFundingBalance returns the funding account balance of a sub-account
+func (s *SubAccountClient) GetFundingBalance(ctx context.Context, param types.GetFundingBalanceParam) (*types.GetFundingBalanceResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/asset/subaccount/balances",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFundingBalanceResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetTransferHistory returns transfers between the master account and sub-accounts
+func (s *SubAccountClient) GetTransferHistory(ctx context.Context, param types.GetTransferHistoryParam) (*types.GetTransferHistoryResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/asset/subaccount/bills",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetTransferHistoryResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// Transfer transfers between sub-accounts with the master account APIKey
+func (s *SubAccountClient) Transfer(ctx context.Context, param types.TransferParam) (*types.TransferResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/asset/subaccount/transfer",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.TransferResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (s *SubAccountClient) CreateAPIKey(ctx context.Context, param types.CreateAPIKeyParam) (*types.APIKeyResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/users/subaccount/create-apikey",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.APIKeyResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (s *SubAccountClient) GetAPIKeys(ctx context.Context, param types.GetAPIKeysParam) (*types.APIKeyResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/users/subaccount/apikey",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.APIKeyResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (s *SubAccountClient) ModifyAPIKey(ctx context.Context, param types.ModifyAPIKeyParam) (*types.APIKeyResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/users/subaccount/modify-apikey",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.APIKeyResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (s *SubAccountClient) DeleteAPIKey(ctx context.Context, param types.DeleteAPIKeyParam) (*types.DeleteAPIKeyResp, error) {
+	err := s.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   s.GetDebug(),
+		BaseURL: s.GetBaseURL(),
+		Path:    "/api/v5/users/subaccount/delete-apikey",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := s.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := s.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.DeleteAPIKeyResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+// CreateAPIKeyParam, Perm is read_only, trade or both separated with comma
+// doc: https://www.okx.com/docs-v5/en/#sub-account-rest-api-create-an-api-key-for-a-sub-account
+type CreateAPIKeyParam struct {
+	SubAcct    string `json:"subAcct" validate:"required"`
+	Label      string `json:"label" validate:"required"`
+	Passphrase string `json:"passphrase" validate:"required"`
+	Perm       string `json:"perm,omitempty"`
+	// up to 20 IP addresses separated with comma
+	Ip string `json:"ip,omitempty"`
+}
+
+type GetAPIKeysParam struct {
+	SubAcct string `url:"subAcct" validate:"required"`
+	ApiKey  string `url:"apiKey,omitempty"`
+}
+
+type ModifyAPIKeyParam struct {
+	SubAcct string `json:"subAcct" validate:"required"`
+	ApiKey  string `json:"apiKey" validate:"required"`
+	Label   string `json:"label,omitempty"`
+	Perm    string `json:"perm,omitempty"`
+	Ip      string `json:"ip,omitempty"`
+}
+
+type APIKeyResp struct {
+	okxutils.Response
+	Data []*APIKey `json:"data"`
+}
+
+// APIKey, SecretKey is only returned when the key is created
+type APIKey struct {
+	SubAcct    string `json:"subAcct"`
+	Label      string `json:"label"`
+	ApiKey     string `json:"apiKey"`
+	SecretKey  string `json:"secretKey"`
+	Passphrase string `json:"passphrase"`
+	Perm       string `json:"perm"`
+	Ip         string `json:"ip"`
+	Ts         string `json:"ts"`
+}
+
+type DeleteAPIKeyParam struct {
+	SubAcct string `json:"subAcct" validate:"required"`
+	ApiKey  string `json:"apiKey" validate:"required"`
+}
+
+type DeleteAPIKeyResp struct {
+	okxutils.Response
+	Data []struct {
+		SubAcct string `json:"subAcct"`
+	} `json:"data"`
+}
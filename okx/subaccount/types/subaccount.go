@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	tatypes "github.com/linstohu/nexapi/okx/tradingaccount/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+)
+
+type GetSubAccountsParam struct {
+	Enable  string `url:"enable,omitempty" validate:"omitempty,oneof=true false"`
+	SubAcct string `url:"subAcct,omitempty"`
+	After   string `url:"after,omitempty"`
+	Before  string `url:"before,omitempty"`
+	Limit   int    `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetSubAccountsResp struct {
+	okxutils.Response
+	Data []*SubAccount `json:"data"`
+}
+
+// SubAccount
+// doc: https://www.okx.com/docs-v5/en/#sub-account-rest-api-get-sub-account-list
+type SubAccount struct {
+	Type        string   `json:"type"`
+	Enable      bool     `json:"enable"`
+	SubAcct     string   `json:"subAcct"`
+	Uid         string   `json:"uid"`
+	Label       string   `json:"label"`
+	Mobile      string   `json:"mobile"`
+	GAuth       bool     `json:"gAuth"`
+	FrozenFunc  []string `json:"frozenFunc"`
+	CanTransOut bool     `json:"canTransOut"`
+	Ts          string   `json:"ts"`
+}
+
+type SubAcctParam struct {
+	SubAcct string `url:"subAcct" validate:"required"`
+}
+
+// GetTradingBalanceResp has the same data as the balance of the trading account
+type GetTradingBalanceResp = tatypes.GetBalanceResp
+
+type GetFundingBalanceParam struct {
+	SubAcct string `url:"subAcct" validate:"required"`
+	// single currency or multiple currencies separated with comma, e.g. BTC,ETH
+	Ccy string `url:"ccy,omitempty"`
+}
+
+type GetFundingBalanceResp struct {
+	okxutils.Response
+	Data []*FundingBalance `json:"data"`
+}
+
+type FundingBalance struct {
+	Ccy       string `json:"ccy"`
+	Bal       string `json:"bal"`
+	FrozenBal string `json:"frozenBal"`
+	AvailBal  string `json:"availBal"`
+}
+
+// GetTransferHistoryParam, Type 0 is master account to sub-account and 1 is sub-account to master account
+type GetTransferHistoryParam struct {
+	Ccy     string `url:"ccy,omitempty"`
+	Type    string `url:"type,omitempty" validate:"omitempty,oneof=0 1"`
+	SubAcct string `url:"subAcct,omitempty"`
+	After   string `url:"after,omitempty"`
+	Before  string `url:"before,omitempty"`
+	Limit   int    `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetTransferHistoryResp struct {
+	okxutils.Response
+	Data []*Transfer `json:"data"`
+}
+
+type Transfer struct {
+	BillId  string `json:"billId"`
+	Ccy     string `json:"ccy"`
+	Amt     string `json:"amt"`
+	Type    string `json:"type"`
+	SubAcct string `json:"subAcct"`
+	Ts      string `json:"ts"`
+}
+
+// TransferParam, From and To are 6 for the funding account and 18 for the trading account
+// doc: https://www.okx.com/docs-v5/en/#sub-account-rest-api-master-accounts-manage-the-transfers-between-sub-accounts
+type TransferParam struct {
+	Ccy            string `json:"ccy" validate:"required"`
+	Amt            string `json:"amt" validate:"required"`
+	From           string `json:"from" validate:"required,oneof=6 18"`
+	To             string `json:"to" validate:"required,oneof=6 18"`
+	FromSubAccount string `json:"fromSubAccount" validate:"required"`
+	ToSubAccount   string `json:"toSubAccount" validate:"required"`
+	LoanTrans      bool   `json:"loanTrans,omitempty"`
+	OmitPosRisk    string `json:"omitPosRisk,omitempty"`
+}
+
+type TransferResp struct {
+	okxutils.Response
+	Data []struct {
+		TransId string `json:"transId"`
+	} `json:"data"`
+}
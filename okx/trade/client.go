@@ -0,0 +1,664 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trade
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-playground/validator"
+	"github.com/linstohu/nexapi/okx/trade/types"
+	okxutils "github.com/linstohu/nexapi/okx/utils"
+	"github.com/linstohu/nexapi/utils"
+)
+
+type TradeClient struct {
+	*okxutils.OKXRestClient
+
+	// validate struct fields
+	validate *validator.Validate
+}
+
+type TradeClientCfg struct {
+	BaseURL    string `validate:"required"`
+	Key        string `validate:"required"`
+	Secret     string `validate:"required"`
+	Passphrase string `validate:"required"`
+	Debug      bool
+	// Demo switches to demo trading
+	Demo bool
+	// Logger
+	Logger *slog.Logger
+}
+
+func NewTradeClient(cfg *TradeClientCfg) (*TradeClient, error) {
+	validator := validator.New()
+
+	err := validator.Struct(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := okxutils.NewOKXRestClient(&okxutils.OKXRestClientCfg{
+		Debug:      cfg.Debug,
+		Demo:       cfg.Demo,
+		Logger:     cfg.Logger,
+		BaseURL:    cfg.BaseURL,
+		Key:        cfg.Key,
+		Secret:     cfg.Secret,
+		Passphrase: cfg.Passphrase,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TradeClient{
+		OKXRestClient: cli,
+		validate:      validator,
+	}, nil
+}
+
+func (t *TradeClient) PlaceOrder(ctx context.Context, param types.PlaceOrderParam) (*types.OrderResultResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.OrderResultResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// BatchPlaceOrders places up to 20 orders at a time
+func (t *TradeClient) BatchPlaceOrders(ctx context.Context, params []types.PlaceOrderParam) (*types.OrderResultResp, error) {
+	if len(params) == 0 {
+		return nil, fmt.Errorf("params must not be empty")
+	}
+
+	for _, v := range params {
+		err := t.validate.Struct(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/batch-orders",
+		Method:  http.MethodPost,
+		Body:    params,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.OrderResultResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradeClient) CancelOrder(ctx context.Context, param types.CancelOrderParam) (*types.OrderResultResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/cancel-order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.OrderResultResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// BatchCancelOrders cancels up to 20 orders at a time
+func (t *TradeClient) BatchCancelOrders(ctx context.Context, params []types.CancelOrderParam) (*types.OrderResultResp, error) {
+	if len(params) == 0 {
+		return nil, fmt.Errorf("params must not be empty")
+	}
+
+	for _, v := range params {
+		err := t.validate.Struct(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/cancel-batch-orders",
+		Method:  http.MethodPost,
+		Body:    params,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.OrderResultResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradeClient) AmendOrder(ctx context.Context, param types.AmendOrderParam) (*types.OrderResultResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/amend-order",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.OrderResultResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// ClosePosition closes the position of an instrument with a market order
+func (t *TradeClient) ClosePosition(ctx context.Context, param types.ClosePositionParam) (*types.ClosePositionResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/close-position",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.ClosePositionResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradeClient) GetOrder(ctx context.Context, param types.GetOrderParam) (*types.GetOrdersResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/order",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrdersResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradeClient) GetPendingOrders(ctx context.Context, param types.GetPendingOrdersParam) (*types.GetOrdersResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/orders-pending",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrdersResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetOrderHistory returns completed orders of the last 7 days
+func (t *TradeClient) GetOrderHistory(ctx context.Context, param types.GetOrderHistoryParam) (*types.GetOrdersResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/orders-history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrdersResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetOrderHistoryArchive returns completed orders of the last 3 months
+func (t *TradeClient) GetOrderHistoryArchive(ctx context.Context, param types.GetOrderHistoryParam) (*types.GetOrdersResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/orders-history-archive",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetOrdersResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetFills returns fills of the last 3 days
+func (t *TradeClient) GetFills(ctx context.Context, param types.GetFillsParam) (*types.GetFillsResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/fills",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFillsResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetFillsHistory returns fills of the last 3 months, InstType is required
+func (t *TradeClient) GetFillsHistory(ctx context.Context, param types.GetFillsParam) (*types.GetFillsResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/fills-history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetFillsResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// PlaceAlgoOrder places conditional, oco, trigger, move_order_stop, iceberg and twap orders
+func (t *TradeClient) PlaceAlgoOrder(ctx context.Context, param types.PlaceAlgoOrderParam) (*types.PlaceAlgoOrderResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/order-algo",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.PlaceAlgoOrderResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// CancelAlgoOrders cancels up to 10 algo orders at a time
+func (t *TradeClient) CancelAlgoOrders(ctx context.Context, params []types.CancelAlgoOrderParam) (*types.PlaceAlgoOrderResp, error) {
+	if len(params) == 0 {
+		return nil, fmt.Errorf("params must not be empty")
+	}
+
+	for _, v := range params {
+		err := t.validate.Struct(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/cancel-algos",
+		Method:  http.MethodPost,
+		Body:    params,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.PlaceAlgoOrderResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradeClient) GetAlgoOrder(ctx context.Context, param types.GetAlgoOrderParam) (*types.GetAlgoOrdersResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/order-algo",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetAlgoOrdersResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradeClient) GetPendingAlgoOrders(ctx context.Context, param types.GetPendingAlgoOrdersParam) (*types.GetAlgoOrdersResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/orders-algo-pending",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetAlgoOrdersResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradeClient) GetAlgoOrderHistory(ctx context.Context, param types.GetAlgoOrderHistoryParam) (*types.GetAlgoOrdersResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/trade/orders-algo-history",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetAlgoOrdersResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trade
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/linstohu/nexapi/okx/trade/types"
+	"github.com/linstohu/nexapi/okx/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testNewTradeClient(t *testing.T) *TradeClient {
+	cli, err := NewTradeClient(&TradeClientCfg{
+		Debug:      true,
+		BaseURL:    utils.RestURL,
+		Key:        os.Getenv("OKX_KEY"),
+		Secret:     os.Getenv("OKX_SECRET"),
+		Passphrase: os.Getenv("OKX_PASS"),
+	})
+
+	if err != nil {
+		t.Fatalf("Could not create okx trade client, %s", err)
+	}
+
+	return cli
+}
+
+func TestPlaceOrderValidatesAttachedAlgoOrders(t *testing.T) {
+	cli, err := NewTradeClient(&TradeClientCfg{
+		BaseURL:    utils.RestURL,
+		Key:        "key",
+		Secret:     "secret",
+		Passphrase: "pass",
+	})
+	assert.Nil(t, err)
+
+	// validation fails before anything is sent
+	_, err = cli.PlaceOrder(context.TODO(), types.PlaceOrderParam{
+		InstId:  "BTC-USDT",
+		TdMode:  "cash",
+		Side:    "buy",
+		OrdType: "limit",
+		Sz:      "1",
+		Px:      "30000",
+		AttachAlgoOrds: []*types.AttachAlgoOrderParam{
+			{TpTriggerPx: "31000", TpOrdPx: "-1", TpTriggerPxType: "last"},
+			{SlTriggerPx: "29000", SlOrdPx: "-1", SlTriggerPxType: "bid"},
+		},
+	})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "AttachAlgoOrds[1].SlTriggerPxType")
+	}
+}
+
+func TestGetPendingOrders(t *testing.T) {
+	cli := testNewTradeClient(t)
+
+	_, err := cli.GetPendingOrders(context.TODO(), types.GetPendingOrdersParam{})
+	assert.Nil(t, err)
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	cli := testNewTradeClient(t)
+
+	_, err := cli.GetOrderHistory(context.TODO(), types.GetOrderHistoryParam{
+		InstType: types.Spot,
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetFills(t *testing.T) {
+	cli := testNewTradeClient(t)
+
+	_, err := cli.GetFills(context.TODO(), types.GetFillsParam{})
+	assert.Nil(t, err)
+}
+
+func TestGetPendingAlgoOrders(t *testing.T) {
+	cli := testNewTradeClient(t)
+
+	_, err := cli.GetPendingAlgoOrders(context.TODO(), types.GetPendingAlgoOrdersParam{
+		OrdType: types.Conditional,
+	})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+type AlgoOrderType = string
+
+const (
+	Conditional   = "conditional"
+	OCO           = "oco"
+	Trigger       = "trigger"
+	MoveOrderStop = "move_order_stop"
+	Iceberg       = "iceberg"
+	TWAP          = "twap"
+)
+
+// PlaceAlgoOrderParam, the fields needed depend on OrdType
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-algo-trading-post-place-algo-order
+type PlaceAlgoOrderParam struct {
+	InstId        string        `json:"instId" validate:"required"`
+	TdMode        string        `json:"tdMode" validate:"required,oneof=cash isolated cross spot_isolated"`
+	Ccy           string        `json:"ccy,omitempty"`
+	Side          string        `json:"side" validate:"required,oneof=buy sell"`
+	PosSide       string        `json:"posSide,omitempty" validate:"omitempty,oneof=long short net"`
+	OrdType       AlgoOrderType `json:"ordType" validate:"required,oneof=conditional oco trigger move_order_stop iceberg twap"`
+	Sz            string        `json:"sz,omitempty"`
+	Tag           string        `json:"tag,omitempty"`
+	TgtCcy        string        `json:"tgtCcy,omitempty" validate:"omitempty,oneof=base_ccy quote_ccy"`
+	AlgoClOrdId   string        `json:"algoClOrdId,omitempty"`
+	CloseFraction string        `json:"closeFraction,omitempty"`
+	ReduceOnly    bool          `json:"reduceOnly,omitempty"`
+	QuickMgnType  string        `json:"quickMgnType,omitempty"`
+
+	// conditional and oco
+	TpTriggerPx     string `json:"tpTriggerPx,omitempty"`
+	TpTriggerPxType string `json:"tpTriggerPxType,omitempty" validate:"omitempty,oneof=last index mark"`
+	TpOrdPx         string `json:"tpOrdPx,omitempty"`
+	SlTriggerPx     string `json:"slTriggerPx,omitempty"`
+	SlTriggerPxType string `json:"slTriggerPxType,omitempty" validate:"omitempty,oneof=last index mark"`
+	SlOrdPx         string `json:"slOrdPx,omitempty"`
+	CxlOnClosePos   bool   `json:"cxlOnClosePos,omitempty"`
+
+	// trigger
+	TriggerPx     string `json:"triggerPx,omitempty"`
+	OrderPx       string `json:"orderPx,omitempty"`
+	TriggerPxType string `json:"triggerPxType,omitempty" validate:"omitempty,oneof=last index mark"`
+
+	// move_order_stop
+	CallbackRatio  string `json:"callbackRatio,omitempty"`
+	CallbackSpread string `json:"callbackSpread,omitempty"`
+	ActivePx       string `json:"activePx,omitempty"`
+
+	// iceberg and twap
+	PxVar        string `json:"pxVar,omitempty"`
+	PxSpread     string `json:"pxSpread,omitempty"`
+	SzLimit      string `json:"szLimit,omitempty"`
+	PxLimit      string `json:"pxLimit,omitempty"`
+	TimeInterval string `json:"timeInterval,omitempty"`
+}
+
+type PlaceAlgoOrderResp struct {
+	okxutils.Response
+	Data []*AlgoOrderResult `json:"data"`
+}
+
+type AlgoOrderResult struct {
+	AlgoId      string `json:"algoId"`
+	ClOrdId     string `json:"clOrdId"`
+	AlgoClOrdId string `json:"algoClOrdId"`
+	Tag         string `json:"tag"`
+	SCode       string `json:"sCode"`
+	SMsg        string `json:"sMsg"`
+}
+
+// CancelAlgoOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-algo-trading-post-cancel-algo-order
+type CancelAlgoOrderParam struct {
+	AlgoId string `json:"algoId" validate:"required"`
+	InstId string `json:"instId" validate:"required"`
+}
+
+// GetAlgoOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-algo-trading-get-algo-order-details
+type GetAlgoOrderParam struct {
+	AlgoId      string `url:"algoId,omitempty" validate:"required_without=AlgoClOrdId"`
+	AlgoClOrdId string `url:"algoClOrdId,omitempty"`
+}
+
+// GetPendingAlgoOrdersParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-algo-trading-get-algo-order-list
+type GetPendingAlgoOrdersParam struct {
+	OrdType     AlgoOrderType  `url:"ordType" validate:"required"`
+	AlgoId      string         `url:"algoId,omitempty"`
+	AlgoClOrdId string         `url:"algoClOrdId,omitempty"`
+	InstType    InstrumentType `url:"instType,omitempty" validate:"omitempty,oneof=SPOT SWAP FUTURES MARGIN"`
+	InstId      string         `url:"instId,omitempty"`
+	After       string         `url:"after,omitempty"`
+	Before      string         `url:"before,omitempty"`
+	Limit       int            `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+// GetAlgoOrderHistoryParam needs either State or AlgoId
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-algo-trading-get-algo-order-history
+type GetAlgoOrderHistoryParam struct {
+	OrdType  AlgoOrderType  `url:"ordType" validate:"required"`
+	State    string         `url:"state,omitempty" validate:"required_without=AlgoId"`
+	AlgoId   string         `url:"algoId,omitempty"`
+	InstType InstrumentType `url:"instType,omitempty" validate:"omitempty,oneof=SPOT SWAP FUTURES MARGIN"`
+	InstId   string         `url:"instId,omitempty"`
+	After    string         `url:"after,omitempty"`
+	Before   string         `url:"before,omitempty"`
+	Limit    int            `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetAlgoOrdersResp struct {
+	okxutils.Response
+	Data []*AlgoOrder `json:"data"`
+}
+
+type AlgoOrder struct {
+	InstType        string   `json:"instType"`
+	InstId          string   `json:"instId"`
+	Ccy             string   `json:"ccy"`
+	OrdId           string   `json:"ordId"`
+	OrdIdList       []string `json:"ordIdList"`
+	AlgoId          string   `json:"algoId"`
+	ClOrdId         string   `json:"clOrdId"`
+	Sz              string   `json:"sz"`
+	CloseFraction   string   `json:"closeFraction"`
+	OrdType         string   `json:"ordType"`
+	Side            string   `json:"side"`
+	PosSide         string   `json:"posSide"`
+	TdMode          string   `json:"tdMode"`
+	TgtCcy          string   `json:"tgtCcy"`
+	State           string   `json:"state"`
+	Lever           string   `json:"lever"`
+	TpTriggerPx     string   `json:"tpTriggerPx"`
+	TpTriggerPxType string   `json:"tpTriggerPxType"`
+	TpOrdPx         string   `json:"tpOrdPx"`
+	SlTriggerPx     string   `json:"slTriggerPx"`
+	SlTriggerPxType string   `json:"slTriggerPxType"`
+	SlOrdPx         string   `json:"slOrdPx"`
+	TriggerPx       string   `json:"triggerPx"`
+	TriggerPxType   string   `json:"triggerPxType"`
+	OrdPx           string   `json:"ordPx"`
+	ActualSz        string   `json:"actualSz"`
+	ActualPx        string   `json:"actualPx"`
+	ActualSide      string   `json:"actualSide"`
+	TriggerTime     string   `json:"triggerTime"`
+	PxVar           string   `json:"pxVar"`
+	PxSpread        string   `json:"pxSpread"`
+	SzLimit         string   `json:"szLimit"`
+	PxLimit         string   `json:"pxLimit"`
+	Tag             string   `json:"tag"`
+	TimeInterval    string   `json:"timeInterval"`
+	CallbackRatio   string   `json:"callbackRatio"`
+	CallbackSpread  string   `json:"callbackSpread"`
+	ActivePx        string   `json:"activePx"`
+	MoveTriggerPx   string   `json:"moveTriggerPx"`
+	ReduceOnly      string   `json:"reduceOnly"`
+	QuickMgnType    string   `json:"quickMgnType"`
+	Last            string   `json:"last"`
+	FailCode        string   `json:"failCode"`
+	AlgoClOrdId     string   `json:"algoClOrdId"`
+	CTime           string   `json:"cTime"`
+}
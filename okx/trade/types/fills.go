@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+// GetFillsParam is used by both the last 3 days and the last 3 months endpoints, InstType is required by the latter
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-transaction-details-last-3-days
+type GetFillsParam struct {
+	InstType   InstrumentType `url:"instType,omitempty" validate:"omitempty,oneof=SPOT MARGIN SWAP FUTURES OPTION"`
+	Uly        string         `url:"uly,omitempty"`
+	InstFamily string         `url:"instFamily,omitempty"`
+	InstId     string         `url:"instId,omitempty"`
+	OrdId      string         `url:"ordId,omitempty"`
+	SubType    string         `url:"subType,omitempty"`
+	After      string         `url:"after,omitempty"`
+	Before     string         `url:"before,omitempty"`
+	Begin      string         `url:"begin,omitempty"`
+	End        string         `url:"end,omitempty"`
+	Limit      int            `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetFillsResp struct {
+	okxutils.Response
+	Data []*Fill `json:"data"`
+}
+
+type Fill struct {
+	InstType    string `json:"instType"`
+	InstId      string `json:"instId"`
+	TradeId     string `json:"tradeId"`
+	OrdId       string `json:"ordId"`
+	ClOrdId     string `json:"clOrdId"`
+	BillId      string `json:"billId"`
+	SubType     string `json:"subType"`
+	Tag         string `json:"tag"`
+	FillPx      string `json:"fillPx"`
+	FillSz      string `json:"fillSz"`
+	FillIdxPx   string `json:"fillIdxPx"`
+	FillPnl     string `json:"fillPnl"`
+	FillPxVol   string `json:"fillPxVol"`
+	FillPxUsd   string `json:"fillPxUsd"`
+	FillMarkVol string `json:"fillMarkVol"`
+	FillFwdPx   string `json:"fillFwdPx"`
+	FillMarkPx  string `json:"fillMarkPx"`
+	Side        string `json:"side"`
+	PosSide     string `json:"posSide"`
+	ExecType    string `json:"execType"`
+	FeeCcy      string `json:"feeCcy"`
+	Fee         string `json:"fee"`
+	Ts          string `json:"ts"`
+	FillTime    string `json:"fillTime"`
+}
@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+type InstrumentType = string
+
+const (
+	Spot    = "SPOT"
+	Margin  = "MARGIN"
+	Swap    = "SWAP"
+	Futures = "FUTURES"
+	Option  = "OPTION"
+)
+
+// PlaceOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-place-order
+type PlaceOrderParam struct {
+	InstId         string                  `json:"instId" validate:"required"`
+	TdMode         string                  `json:"tdMode" validate:"required,oneof=cash isolated cross spot_isolated"`
+	Ccy            string                  `json:"ccy,omitempty"`
+	ClOrdId        string                  `json:"clOrdId,omitempty"`
+	Tag            string                  `json:"tag,omitempty"`
+	Side           string                  `json:"side" validate:"required,oneof=buy sell"`
+	PosSide        string                  `json:"posSide,omitempty" validate:"omitempty,oneof=long short net"`
+	OrdType        string                  `json:"ordType" validate:"required,oneof=market limit post_only fok ioc optimal_limit_ioc mmp mmp_and_post_only"`
+	Sz             string                  `json:"sz" validate:"required"`
+	Px             string                  `json:"px,omitempty"`
+	PxUsd          string                  `json:"pxUsd,omitempty"`
+	PxVol          string                  `json:"pxVol,omitempty"`
+	ReduceOnly     bool                    `json:"reduceOnly,omitempty"`
+	TgtCcy         string                  `json:"tgtCcy,omitempty" validate:"omitempty,oneof=base_ccy quote_ccy"`
+	BanAmend       bool                    `json:"banAmend,omitempty"`
+	QuickMgnType   string                  `json:"quickMgnType,omitempty"`
+	StpId          string                  `json:"stpId,omitempty"`
+	StpMode        string                  `json:"stpMode,omitempty" validate:"omitempty,oneof=cancel_maker cancel_taker cancel_both"`
+	AttachAlgoOrds []*AttachAlgoOrderParam `json:"attachAlgoOrds,omitempty" validate:"omitempty,dive"`
+}
+
+// AttachAlgoOrderParam attaches take profit and stop loss orders to an order
+type AttachAlgoOrderParam struct {
+	AttachAlgoClOrdId string `json:"attachAlgoClOrdId,omitempty"`
+	TpTriggerPx       string `json:"tpTriggerPx,omitempty"`
+	TpOrdPx           string `json:"tpOrdPx,omitempty"`
+	SlTriggerPx       string `json:"slTriggerPx,omitempty"`
+	SlOrdPx           string `json:"slOrdPx,omitempty"`
+	TpTriggerPxType   string `json:"tpTriggerPxType,omitempty" validate:"omitempty,oneof=last index mark"`
+	SlTriggerPxType   string `json:"slTriggerPxType,omitempty" validate:"omitempty,oneof=last index mark"`
+	Sz                string `json:"sz,omitempty"`
+}
+
+// CancelOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-cancel-order
+type CancelOrderParam struct {
+	InstId  string `json:"instId" validate:"required"`
+	OrdId   string `json:"ordId,omitempty" validate:"required_without=ClOrdId"`
+	ClOrdId string `json:"clOrdId,omitempty"`
+}
+
+// AmendOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-amend-order
+type AmendOrderParam struct {
+	InstId    string `json:"instId" validate:"required"`
+	CxlOnFail bool   `json:"cxlOnFail,omitempty"`
+	OrdId     string `json:"ordId,omitempty" validate:"required_without=ClOrdId"`
+	ClOrdId   string `json:"clOrdId,omitempty"`
+	ReqId     string `json:"reqId,omitempty"`
+	NewSz     string `json:"newSz,omitempty"`
+	NewPx     string `json:"newPx,omitempty"`
+	NewPxUsd  string `json:"newPxUsd,omitempty"`
+	NewPxVol  string `json:"newPxVol,omitempty"`
+}
+
+// OrderResult is the per-order result of place, cancel and amend requests, check SCode for each order
+type OrderResult struct {
+	ClOrdId string `json:"clOrdId"`
+	OrdId   string `json:"ordId"`
+	Tag     string `json:"tag"`
+	ReqId   string `json:"reqId"`
+	Ts      string `json:"ts"`
+	SCode   string `json:"sCode"`
+	SMsg    string `json:"sMsg"`
+}
+
+type OrderResultResp struct {
+	okxutils.Response
+	Data []*OrderResult `json:"data"`
+}
+
+// ClosePositionParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-close-positions
+type ClosePositionParam struct {
+	InstId  string `json:"instId" validate:"required"`
+	PosSide string `json:"posSide,omitempty" validate:"omitempty,oneof=long short net"`
+	MgnMode string `json:"mgnMode" validate:"required,oneof=cross isolated"`
+	Ccy     string `json:"ccy,omitempty"`
+	AutoCxl bool   `json:"autoCxl,omitempty"`
+	ClOrdId string `json:"clOrdId,omitempty"`
+	Tag     string `json:"tag,omitempty"`
+}
+
+type ClosePositionResp struct {
+	okxutils.Response
+	Data []*ClosePositionResult `json:"data"`
+}
+
+type ClosePositionResult struct {
+	InstId  string `json:"instId"`
+	PosSide string `json:"posSide"`
+	ClOrdId string `json:"clOrdId"`
+	Tag     string `json:"tag"`
+}
+
+// GetOrderParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-order-details
+type GetOrderParam struct {
+	InstId  string `url:"instId" validate:"required"`
+	OrdId   string `url:"ordId,omitempty" validate:"required_without=ClOrdId"`
+	ClOrdId string `url:"clOrdId,omitempty"`
+}
+
+// GetPendingOrdersParam
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-order-list
+type GetPendingOrdersParam struct {
+	InstType   InstrumentType `url:"instType,omitempty" validate:"omitempty,oneof=SPOT MARGIN SWAP FUTURES OPTION"`
+	Uly        string         `url:"uly,omitempty"`
+	InstFamily string         `url:"instFamily,omitempty"`
+	InstId     string         `url:"instId,omitempty"`
+	OrdType    string         `url:"ordType,omitempty"`
+	State      string         `url:"state,omitempty" validate:"omitempty,oneof=live partially_filled"`
+	After      string         `url:"after,omitempty"`
+	Before     string         `url:"before,omitempty"`
+	Limit      int            `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+// GetOrderHistoryParam is used by both the last 7 days and the last 3 months endpoints
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-order-history-last-7-days
+type GetOrderHistoryParam struct {
+	InstType   InstrumentType `url:"instType" validate:"required,oneof=SPOT MARGIN SWAP FUTURES OPTION"`
+	Uly        string         `url:"uly,omitempty"`
+	InstFamily string         `url:"instFamily,omitempty"`
+	InstId     string         `url:"instId,omitempty"`
+	OrdType    string         `url:"ordType,omitempty"`
+	State      string         `url:"state,omitempty" validate:"omitempty,oneof=canceled filled mmp_canceled"`
+	Category   string         `url:"category,omitempty"`
+	After      string         `url:"after,omitempty"`
+	Before     string         `url:"before,omitempty"`
+	Begin      string         `url:"begin,omitempty"`
+	End        string         `url:"end,omitempty"`
+	Limit      int            `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetOrdersResp struct {
+	okxutils.Response
+	Data []*Order `json:"data"`
+}
+
+// Order
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-order-details
+type Order struct {
+	InstType        string `json:"instType"`
+	InstId          string `json:"instId"`
+	TgtCcy          string `json:"tgtCcy"`
+	Ccy             string `json:"ccy"`
+	OrdId           string `json:"ordId"`
+	ClOrdId         string `json:"clOrdId"`
+	Tag             string `json:"tag"`
+	Px              string `json:"px"`
+	PxUsd           string `json:"pxUsd"`
+	PxVol           string `json:"pxVol"`
+	PxType          string `json:"pxType"`
+	Sz              string `json:"sz"`
+	Pnl             string `json:"pnl"`
+	OrdType         string `json:"ordType"`
+	Side            string `json:"side"`
+	PosSide         string `json:"posSide"`
+	TdMode          string `json:"tdMode"`
+	AccFillSz       string `json:"accFillSz"`
+	FillPx          string `json:"fillPx"`
+	TradeId         string `json:"tradeId"`
+	FillSz          string `json:"fillSz"`
+	FillTime        string `json:"fillTime"`
+	AvgPx           string `json:"avgPx"`
+	State           string `json:"state"`
+	Lever           string `json:"lever"`
+	TpTriggerPx     string `json:"tpTriggerPx"`
+	TpTriggerPxType string `json:"tpTriggerPxType"`
+	TpOrdPx         string `json:"tpOrdPx"`
+	SlTriggerPx     string `json:"slTriggerPx"`
+	SlTriggerPxType string `json:"slTriggerPxType"`
+	SlOrdPx         string `json:"slOrdPx"`
+	StpId           string `json:"stpId"`
+	StpMode         string `json:"stpMode"`
+	FeeCcy          string `json:"feeCcy"`
+	Fee             string `json:"fee"`
+	RebateCcy       string `json:"rebateCcy"`
+	Rebate          string `json:"rebate"`
+	Source          string `json:"source"`
+	Category        string `json:"category"`
+	ReduceOnly      string `json:"reduceOnly"`
+	CancelSource    string `json:"cancelSource"`
+	QuickMgnType    string `json:"quickMgnType"`
+	AlgoClOrdId     string `json:"algoClOrdId"`
+	AlgoId          string `json:"algoId"`
+	UTime           string `json:"uTime"`
+	CTime           string `json:"cTime"`
+}
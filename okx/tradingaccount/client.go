@@ -41,6 +41,8 @@ type TradingAccountClientCfg struct {
 	Secret     string `validate:"required"`
 	Passphrase string `validate:"required"`
 	Debug      bool
+	// Demo switches to demo trading
+	Demo bool
 	// Logger
 	Logger *slog.Logger
 }
@@ -55,6 +57,7 @@ func NewTradingAccountClient(cfg *TradingAccountClientCfg) (*TradingAccountClien
 
 	cli, err := okxutils.NewOKXRestClient(&okxutils.OKXRestClientCfg{
 		Debug:      cfg.Debug,
+		Demo:       cfg.Demo,
 		Logger:     cfg.Logger,
 		BaseURL:    cfg.BaseURL,
 		Key:        cfg.Key,
@@ -136,3 +139,464 @@ func (t *TradingAccountClient) GetPositions(ctx context.Context, param types.Get
 
 	return &body, nil
 }
+
+func (t *TradingAccountClient) GetAccountConfig(ctx context.Context) (*types.GetAccountConfigResp, error) {
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/config",
+		Method:  http.MethodGet,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetAccountConfigResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradingAccountClient) SetPositionMode(ctx context.Context, param types.SetPositionModeParam) (*types.SetPositionModeResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/set-position-mode",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.SetPositionModeResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradingAccountClient) SetLeverage(ctx context.Context, param types.SetLeverageParam) (*types.LeverageResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/set-leverage",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.LeverageResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradingAccountClient) GetLeverageInfo(ctx context.Context, param types.GetLeverageInfoParam) (*types.LeverageResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/leverage-info",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.LeverageResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetMaxSize returns the maximum order quantity
+func (t *TradingAccountClient) GetMaxSize(ctx context.Context, param types.GetMaxSizeParam) (*types.GetMaxSizeResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/max-size",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetMaxSizeResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetMaxAvailSize returns the maximum available tradable amount
+func (t *TradingAccountClient) GetMaxAvailSize(ctx context.Context, param types.GetMaxAvailSizeParam) (*types.GetMaxAvailSizeResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/max-avail-size",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetMaxAvailSizeResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradingAccountClient) AdjustMargin(ctx context.Context, param types.AdjustMarginParam) (*types.AdjustMarginResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/position/margin-balance",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.AdjustMarginResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetBills returns bills of the last 7 days
+func (t *TradingAccountClient) GetBills(ctx context.Context, param types.GetBillsParam) (*types.GetBillsResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/bills",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetBillsResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetBillsArchive returns bills of the last 3 months
+func (t *TradingAccountClient) GetBillsArchive(ctx context.Context, param types.GetBillsParam) (*types.GetBillsResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/bills-archive",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetBillsResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradingAccountClient) GetInterestAccrued(ctx context.Context, param types.GetInterestAccruedParam) (*types.GetInterestAccruedResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/interest-accrued",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetInterestAccruedResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradingAccountClient) GetPositionRisk(ctx context.Context, param types.GetPositionRiskParam) (*types.GetPositionRiskResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/account-position-risk",
+		Method:  http.MethodGet,
+		Query:   param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.GetPositionRiskResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// SetGreeks sets the greeks display type
+func (t *TradingAccountClient) SetGreeks(ctx context.Context, param types.SetGreeksParam) (*types.SetGreeksResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/set-greeks",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.SetGreeksResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradingAccountClient) SetIsolatedMode(ctx context.Context, param types.SetIsolatedModeParam) (*types.SetIsolatedModeResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/set-isolated-mode",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.SetIsolatedModeResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+func (t *TradingAccountClient) PositionBuilder(ctx context.Context, param types.PositionBuilderParam) (*types.PositionBuilderResp, error) {
+	err := t.validate.Struct(param)
+	if err != nil {
+		return nil, err
+	}
+
+	req := utils.HTTPRequest{
+		Debug:   t.GetDebug(),
+		BaseURL: t.GetBaseURL(),
+		Path:    "/api/v5/account/position-builder",
+		Method:  http.MethodPost,
+		Body:    param,
+	}
+
+	headers, err := t.GenAuthHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	req.Headers = headers
+
+	resp, err := t.SendHTTPRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body types.PositionBuilderResp
+	if err := resp.ReadJsonBody(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
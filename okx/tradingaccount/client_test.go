@@ -49,3 +49,42 @@ func TestGetBalance(t *testing.T) {
 	_, err := cli.GetBalance(context.TODO(), types.GetBalanceParam{})
 	assert.Nil(t, err)
 }
+
+func TestGetAccountConfig(t *testing.T) {
+	cli := testNewTradingAccountClient(t)
+
+	_, err := cli.GetAccountConfig(context.TODO())
+	assert.Nil(t, err)
+}
+
+func TestGetBills(t *testing.T) {
+	cli := testNewTradingAccountClient(t)
+
+	_, err := cli.GetBills(context.TODO(), types.GetBillsParam{})
+	assert.Nil(t, err)
+}
+
+func TestGetMaxSize(t *testing.T) {
+	cli := testNewTradingAccountClient(t)
+
+	_, err := cli.GetMaxSize(context.TODO(), types.GetMaxSizeParam{
+		InstId: "BTC-USDT",
+		TdMode: "cash",
+	})
+	assert.Nil(t, err)
+}
+
+func TestGetBalanceInDemoMode(t *testing.T) {
+	cli, err := NewTradingAccountClient(&TradingAccountClientCfg{
+		Debug:      true,
+		Demo:       true,
+		BaseURL:    utils.RestURL,
+		Key:        os.Getenv("OKX_DEMO_KEY"),
+		Secret:     os.Getenv("OKX_DEMO_SECRET"),
+		Passphrase: os.Getenv("OKX_DEMO_PASS"),
+	})
+	assert.Nil(t, err)
+
+	_, err = cli.GetBalance(context.TODO(), types.GetBalanceParam{})
+	assert.Nil(t, err)
+}
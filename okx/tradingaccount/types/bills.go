@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+// GetBillsParam is used by both the last 7 days and the last 3 months endpoints
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-bills-details-last-7-days
+type GetBillsParam struct {
+	InstType PosInstType `url:"instType,omitempty" validate:"omitempty,oneof=SPOT MARGIN SWAP FUTURES OPTION"`
+	Ccy      string      `url:"ccy,omitempty"`
+	MgnMode  string      `url:"mgnMode,omitempty" validate:"omitempty,oneof=isolated cross"`
+	CtType   string      `url:"ctType,omitempty" validate:"omitempty,oneof=linear inverse"`
+	Type     string      `url:"type,omitempty"`
+	SubType  string      `url:"subType,omitempty"`
+	After    string      `url:"after,omitempty"`
+	Before   string      `url:"before,omitempty"`
+	Begin    string      `url:"begin,omitempty"`
+	End      string      `url:"end,omitempty"`
+	Limit    int         `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetBillsResp struct {
+	okxutils.Response
+	Data []*Bill `json:"data"`
+}
+
+type Bill struct {
+	InstType   string `json:"instType"`
+	BillId     string `json:"billId"`
+	Type       string `json:"type"`
+	SubType    string `json:"subType"`
+	Ts         string `json:"ts"`
+	BalChg     string `json:"balChg"`
+	PosBalChg  string `json:"posBalChg"`
+	Bal        string `json:"bal"`
+	PosBal     string `json:"posBal"`
+	Sz         string `json:"sz"`
+	Px         string `json:"px"`
+	Ccy        string `json:"ccy"`
+	Pnl        string `json:"pnl"`
+	Fee        string `json:"fee"`
+	MgnMode    string `json:"mgnMode"`
+	InstId     string `json:"instId"`
+	OrdId      string `json:"ordId"`
+	ExecType   string `json:"execType"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Notes      string `json:"notes"`
+	Interest   string `json:"interest"`
+	Tag        string `json:"tag"`
+	FillTime   string `json:"fillTime"`
+	TradeId    string `json:"tradeId"`
+	ClOrdId    string `json:"clOrdId"`
+	FillIdxPx  string `json:"fillIdxPx"`
+	FillMarkPx string `json:"fillMarkPx"`
+}
+
+// GetInterestAccruedParam
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-interest-accrued-data
+type GetInterestAccruedParam struct {
+	Type    string `url:"type,omitempty" validate:"omitempty,oneof=1 2"`
+	Ccy     string `url:"ccy,omitempty"`
+	InstId  string `url:"instId,omitempty"`
+	MgnMode string `url:"mgnMode,omitempty" validate:"omitempty,oneof=isolated cross"`
+	After   string `url:"after,omitempty"`
+	Before  string `url:"before,omitempty"`
+	Limit   int    `url:"limit,omitempty" validate:"omitempty,max=100"`
+}
+
+type GetInterestAccruedResp struct {
+	okxutils.Response
+	Data []*InterestAccrued `json:"data"`
+}
+
+type InterestAccrued struct {
+	Type         string `json:"type"`
+	Ccy          string `json:"ccy"`
+	InstId       string `json:"instId"`
+	MgnMode      string `json:"mgnMode"`
+	Interest     string `json:"interest"`
+	InterestRate string `json:"interestRate"`
+	Liab         string `json:"liab"`
+	Ts           string `json:"ts"`
+}
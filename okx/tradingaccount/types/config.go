@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+type GetAccountConfigResp struct {
+	okxutils.Response
+	Data []*AccountConfig `json:"data"`
+}
+
+// AccountConfig
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-account-configuration
+type AccountConfig struct {
+	Uid             string   `json:"uid"`
+	MainUid         string   `json:"mainUid"`
+	AcctLv          string   `json:"acctLv"`
+	PosMode         string   `json:"posMode"`
+	AutoLoan        bool     `json:"autoLoan"`
+	GreeksType      string   `json:"greeksType"`
+	Level           string   `json:"level"`
+	LevelTmp        string   `json:"levelTmp"`
+	CtIsoMode       string   `json:"ctIsoMode"`
+	MgnIsoMode      string   `json:"mgnIsoMode"`
+	SpotOffsetType  string   `json:"spotOffsetType"`
+	RoleType        string   `json:"roleType"`
+	TraderInsts     []string `json:"traderInsts"`
+	SpotRoleType    string   `json:"spotRoleType"`
+	SpotTraderInsts []string `json:"spotTraderInsts"`
+	OpAuth          string   `json:"opAuth"`
+	KycLv           string   `json:"kycLv"`
+	Label           string   `json:"label"`
+	Ip              string   `json:"ip"`
+	Perm            string   `json:"perm"`
+}
+
+type SetPositionModeParam struct {
+	PosMode string `json:"posMode" validate:"required,oneof=long_short_mode net_mode"`
+}
+
+type SetPositionModeResp struct {
+	okxutils.Response
+	Data []*SetPositionModeParam `json:"data"`
+}
+
+// SetLeverageParam
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-set-leverage
+type SetLeverageParam struct {
+	InstId  string `json:"instId,omitempty" validate:"required_without=Ccy"`
+	Ccy     string `json:"ccy,omitempty"`
+	Lever   string `json:"lever" validate:"required"`
+	MgnMode string `json:"mgnMode" validate:"required,oneof=isolated cross"`
+	PosSide string `json:"posSide,omitempty" validate:"omitempty,oneof=long short"`
+}
+
+type GetLeverageInfoParam struct {
+	InstId  string `url:"instId" validate:"required"`
+	MgnMode string `url:"mgnMode" validate:"required,oneof=isolated cross"`
+}
+
+type LeverageResp struct {
+	okxutils.Response
+	Data []*Leverage `json:"data"`
+}
+
+type Leverage struct {
+	InstId  string `json:"instId"`
+	Ccy     string `json:"ccy"`
+	MgnMode string `json:"mgnMode"`
+	PosSide string `json:"posSide"`
+	Lever   string `json:"lever"`
+}
+
+// GetMaxSizeParam
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-maximum-order-quantity
+type GetMaxSizeParam struct {
+	InstId       string `url:"instId" validate:"required"`
+	TdMode       string `url:"tdMode" validate:"required,oneof=cash isolated cross spot_isolated"`
+	Ccy          string `url:"ccy,omitempty"`
+	Px           string `url:"px,omitempty"`
+	Leverage     string `url:"leverage,omitempty"`
+	UnSpotOffset bool   `url:"unSpotOffset,omitempty"`
+}
+
+type GetMaxSizeResp struct {
+	okxutils.Response
+	Data []*MaxSize `json:"data"`
+}
+
+type MaxSize struct {
+	InstId  string `json:"instId"`
+	Ccy     string `json:"ccy"`
+	MaxBuy  string `json:"maxBuy"`
+	MaxSell string `json:"maxSell"`
+}
+
+// GetMaxAvailSizeParam
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-maximum-available-tradable-amount
+type GetMaxAvailSizeParam struct {
+	InstId       string `url:"instId" validate:"required"`
+	TdMode       string `url:"tdMode" validate:"required,oneof=cash isolated cross spot_isolated"`
+	Ccy          string `url:"ccy,omitempty"`
+	ReduceOnly   bool   `url:"reduceOnly,omitempty"`
+	Px           string `url:"px,omitempty"`
+	UnSpotOffset bool   `url:"unSpotOffset,omitempty"`
+	QuickMgnType string `url:"quickMgnType,omitempty"`
+}
+
+type GetMaxAvailSizeResp struct {
+	okxutils.Response
+	Data []*MaxAvailSize `json:"data"`
+}
+
+type MaxAvailSize struct {
+	InstId    string `json:"instId"`
+	AvailBuy  string `json:"availBuy"`
+	AvailSell string `json:"availSell"`
+}
+
+// AdjustMarginParam increases or decreases the margin of an isolated position
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-increase-decrease-margin
+type AdjustMarginParam struct {
+	InstId    string `json:"instId" validate:"required"`
+	PosSide   string `json:"posSide" validate:"required,oneof=long short net"`
+	Type      string `json:"type" validate:"required,oneof=add reduce"`
+	Amt       string `json:"amt" validate:"required"`
+	Ccy       string `json:"ccy,omitempty"`
+	LoanTrans bool   `json:"loanTrans,omitempty"`
+}
+
+type AdjustMarginResp struct {
+	okxutils.Response
+	Data []*AdjustMargin `json:"data"`
+}
+
+type AdjustMargin struct {
+	InstId   string `json:"instId"`
+	PosSide  string `json:"posSide"`
+	Amt      string `json:"amt"`
+	Type     string `json:"type"`
+	Leverage string `json:"leverage"`
+	Ccy      string `json:"ccy"`
+}
+
+type SetGreeksParam struct {
+	// PA means greeks in coins, BS means Black-Scholes greeks in dollars
+	GreeksType string `json:"greeksType" validate:"required,oneof=PA BS"`
+}
+
+type SetGreeksResp struct {
+	okxutils.Response
+	Data []*SetGreeksParam `json:"data"`
+}
+
+// SetIsolatedModeParam
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-isolated-margin-trading-settings
+type SetIsolatedModeParam struct {
+	IsoMode string `json:"isoMode" validate:"required,oneof=automatic autonomy"`
+	Type    string `json:"type" validate:"required,oneof=MARGIN CONTRACTS"`
+}
+
+type SetIsolatedModeResp struct {
+	okxutils.Response
+	Data []struct {
+		IsoMode string `json:"isoMode"`
+	} `json:"data"`
+}
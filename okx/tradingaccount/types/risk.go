@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import okxutils "github.com/linstohu/nexapi/okx/utils"
+
+type GetPositionRiskParam struct {
+	InstType PosInstType `url:"instType,omitempty" validate:"omitempty,oneof=MARGIN SWAP FUTURES OPTION"`
+}
+
+type GetPositionRiskResp struct {
+	okxutils.Response
+	Data []*PositionRisk `json:"data"`
+}
+
+// PositionRisk
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-account-and-position-risk
+type PositionRisk struct {
+	AdjEq   string `json:"adjEq"`
+	BalData []struct {
+		Ccy   string `json:"ccy"`
+		DisEq string `json:"disEq"`
+		Eq    string `json:"eq"`
+	} `json:"balData"`
+	PosData []struct {
+		InstType    string `json:"instType"`
+		MgnMode     string `json:"mgnMode"`
+		PosId       string `json:"posId"`
+		InstId      string `json:"instId"`
+		Pos         string `json:"pos"`
+		BaseBal     string `json:"baseBal"`
+		QuoteBal    string `json:"quoteBal"`
+		PosSide     string `json:"posSide"`
+		PosCcy      string `json:"posCcy"`
+		Ccy         string `json:"ccy"`
+		NotionalCcy string `json:"notionalCcy"`
+		NotionalUsd string `json:"notionalUsd"`
+	} `json:"posData"`
+	Ts string `json:"ts"`
+}
+
+// PositionBuilderParam calculates portfolio margin information for virtual positions
+// doc: https://www.okx.com/docs-v5/en/#trading-account-rest-api-position-builder-new
+type PositionBuilderParam struct {
+	InclRealPosAndEq bool                 `json:"inclRealPosAndEq"`
+	SpotOffsetType   string               `json:"spotOffsetType,omitempty" validate:"omitempty,oneof=1 2 3"`
+	GreeksType       string               `json:"greeksType,omitempty" validate:"omitempty,oneof=BS PA CASH"`
+	SimPos           []*SimulatedPosition `json:"simPos,omitempty"`
+	SimAsset         []*SimulatedAsset    `json:"simAsset,omitempty"`
+}
+
+type SimulatedPosition struct {
+	InstId string `json:"instId" validate:"required"`
+	Pos    string `json:"pos" validate:"required"`
+}
+
+type SimulatedAsset struct {
+	Ccy string `json:"ccy" validate:"required"`
+	Amt string `json:"amt" validate:"required"`
+}
+
+type PositionBuilderResp struct {
+	okxutils.Response
+	Data []*PositionBuilder `json:"data"`
+}
+
+type PositionBuilder struct {
+	Eq          string `json:"eq"`
+	TotalMmr    string `json:"totalMmr"`
+	TotalImr    string `json:"totalImr"`
+	BorrowMmr   string `json:"borrowMmr"`
+	DerivMmr    string `json:"derivMmr"`
+	MarginRatio string `json:"marginRatio"`
+	Upl         string `json:"upl"`
+	AcctLever   string `json:"acctLever"`
+	Ts          string `json:"ts"`
+	Assets      []struct {
+		Ccy       string `json:"ccy"`
+		AvailEq   string `json:"availEq"`
+		SpotInUse string `json:"spotInUse"`
+		BorrowMmr string `json:"borrowMmr"`
+		BorrowImr string `json:"borrowImr"`
+	} `json:"assets"`
+	RiskUnitData []struct {
+		RiskUnit       string `json:"riskUnit"`
+		IndexUsd       string `json:"indexUsd"`
+		Mmr            string `json:"mmr"`
+		Imr            string `json:"imr"`
+		Upl            string `json:"upl"`
+		MrBeforeOffset string `json:"mrBeforeOffset"`
+		Positions      []struct {
+			InstId      string `json:"instId"`
+			InstType    string `json:"instType"`
+			Pos         string `json:"pos"`
+			AvgPx       string `json:"avgPx"`
+			NotionalUsd string `json:"notionalUsd"`
+			Delta       string `json:"delta"`
+			Gamma       string `json:"gamma"`
+			Vega        string `json:"vega"`
+			Theta       string `json:"theta"`
+		} `json:"positions"`
+	} `json:"riskUnitData"`
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Candle is returned by candlestick endpoints and channels as [ts, o, h, l, c, vol, volCcy, volCcyQuote, confirm].
+// doc: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-get-candlesticks
+type Candle struct {
+	Ts          string
+	Open        string
+	High        string
+	Low         string
+	Close       string
+	Vol         string
+	VolCcy      string
+	VolCcyQuote string
+	// 0 means the candle is uncompleted, 1 means completed
+	Confirm string
+}
+
+func (c *Candle) UnmarshalJSON(data []byte) error {
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if len(v) < 9 {
+		return fmt.Errorf("invalid candle: %s", string(data))
+	}
+
+	c.Ts, c.Open, c.High, c.Low, c.Close = v[0], v[1], v[2], v[3], v[4]
+	c.Vol, c.VolCcy, c.VolCcyQuote, c.Confirm = v[5], v[6], v[7], v[8]
+
+	return nil
+}
+
+// PriceCandle is returned by mark price and index candlestick endpoints and channels as [ts, o, h, l, c, confirm].
+// doc: https://www.okx.com/docs-v5/en/#public-data-rest-api-get-index-candlesticks
+type PriceCandle struct {
+	Ts      string
+	Open    string
+	High    string
+	Low     string
+	Close   string
+	Confirm string
+}
+
+func (c *PriceCandle) UnmarshalJSON(data []byte) error {
+	var v []string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if len(v) < 6 {
+		return fmt.Errorf("invalid candle: %s", string(data))
+	}
+
+	c.Ts, c.Open, c.High, c.Low, c.Close, c.Confirm = v[0], v[1], v[2], v[3], v[4], v[5]
+
+	return nil
+}
@@ -19,5 +19,5 @@ package utils
 
 type Response struct {
 	Code    string `json:"code"`
-	Message string `json:"messsage"`
+	Message string `json:"msg"`
 }
@@ -42,6 +42,8 @@ type OKXRestClient struct {
 	key, secret, passphrase string
 	// debug mode
 	debug bool
+	// demo trading
+	demo bool
 	// logger
 	logger *slog.Logger
 	// validate struct fields
@@ -54,6 +56,8 @@ type OKXRestClientCfg struct {
 	Secret     string
 	Passphrase string
 	Debug      bool
+	// Demo sends every request with the x-simulated-trading header
+	Demo bool
 	// Logger
 	Logger *slog.Logger
 }
@@ -72,6 +76,7 @@ func NewOKXRestClient(cfg *OKXRestClientCfg) (*OKXRestClient, error) {
 		secret:     cfg.Secret,
 		passphrase: cfg.Passphrase,
 		debug:      cfg.Debug,
+		demo:       cfg.Demo,
 		logger:     cfg.Logger,
 
 		validate: validator,
@@ -88,6 +93,10 @@ func (o *OKXRestClient) GetDebug() bool {
 	return o.debug
 }
 
+func (o *OKXRestClient) GetDemo() bool {
+	return o.demo
+}
+
 func (o *OKXRestClient) GetBaseURL() string {
 	return o.baseURL
 }
@@ -137,6 +146,10 @@ func (o *OKXRestClient) SendHTTPRequest(ctx context.Context, req utils.HTTPReque
 		request.Header.Set(k, v)
 	}
 
+	if o.demo {
+		request.Header.Set("x-simulated-trading", "1")
+	}
+
 	if o.debug {
 		dump, err := httputil.DumpRequestOut(request, true)
 		if err != nil {
@@ -202,11 +215,7 @@ func (o *OKXRestClient) GenAuthHeaders(req utils.HTTPRequest) (map[string]string
 	}
 
 	timestamp := time.Now().UTC().Format(time.RFC3339)
-	signString := fmt.Sprintf("%s%s%s%s", timestamp, req.Method, path, strBody)
-
-	h := hmac.New(sha256.New, []byte(o.secret))
-	h.Write([]byte(signString))
-	signature := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	signature := Sign(o.secret, timestamp, req.Method, path, strBody)
 
 	headers["OK-ACCESS-KEY"] = o.key
 	headers["OK-ACCESS-PASSPHRASE"] = o.passphrase
@@ -215,3 +224,11 @@ func (o *OKXRestClient) GenAuthHeaders(req utils.HTTPRequest) (map[string]string
 
 	return headers, nil
 }
+
+// Sign generates the OK-ACCESS-SIGN value, it is shared by REST requests and websocket login.
+func Sign(secret, timestamp, method, path, body string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp + method + path + body))
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
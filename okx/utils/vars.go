@@ -21,12 +21,39 @@ var (
 	RestURL      = "https://www.okx.com"
 	PublicWsURL  = "wss://ws.okx.com:8443/ws/v5/public"
 	PrivateWsURL = "wss://ws.okx.com:8443/ws/v5/private"
+	// candlestick channels are only served by the business endpoint
+	BusinessWsURL = "wss://ws.okx.com:8443/ws/v5/business"
 
-	AWSRestURL      = "https://aws.okx.com"
-	AWSPublicWsURL  = "wss://wsaws.okx.com:8443/ws/v5/public"
-	AWSPrivateWsURL = "wss://wsaws.okx.com:8443/ws/v5/private"
+	AWSRestURL       = "https://aws.okx.com"
+	AWSPublicWsURL   = "wss://wsaws.okx.com:8443/ws/v5/public"
+	AWSPrivateWsURL  = "wss://wsaws.okx.com:8443/ws/v5/private"
+	AWSBusinessWsURL = "wss://wsaws.okx.com:8443/ws/v5/business"
+
+	// demo trading shares RestURL, REST requests are told apart by the x-simulated-trading header
+	DemoPublicWsURL   = "wss://wspap.okx.com:8443/ws/v5/public"
+	DemoPrivateWsURL  = "wss://wspap.okx.com:8443/ws/v5/private"
+	DemoBusinessWsURL = "wss://wspap.okx.com:8443/ws/v5/business"
 )
 
+// GetWsURL returns the demo trading equivalent of a live websocket endpoint when demo is true,
+// so the same endpoint constants can be used for both live and demo trading.
+func GetWsURL(url string, demo bool) string {
+	if !demo {
+		return url
+	}
+
+	switch url {
+	case PublicWsURL, AWSPublicWsURL:
+		return DemoPublicWsURL
+	case PrivateWsURL, AWSPrivateWsURL:
+		return DemoPrivateWsURL
+	case BusinessWsURL, AWSBusinessWsURL:
+		return DemoBusinessWsURL
+	}
+
+	return url
+}
+
 type InstrumentType = string
 
 const (
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	WsSubscribe   = "subscribe"
+	WsUnsubscribe = "unsubscribe"
+	WsLogin       = "login"
+	WsError       = "error"
+
+	// WsPing is sent as a plain text frame, the server answers with WsPong
+	WsPing = "ping"
+	WsPong = "pong"
+)
+
+// Arg identifies a websocket channel, it is echoed back in every push of the channel.
+type Arg struct {
+	Channel    string `json:"channel"`
+	InstType   string `json:"instType,omitempty"`
+	InstFamily string `json:"instFamily,omitempty"`
+	InstId     string `json:"instId,omitempty"`
+	Ccy        string `json:"ccy,omitempty"`
+}
+
+// Topic encodes the arg as "channel:key=value:...", it is the event name listeners are registered with.
+func (a *Arg) Topic() string {
+	var sb strings.Builder
+
+	sb.WriteString(a.Channel)
+
+	for _, v := range [][2]string{
+		{"instType", a.InstType},
+		{"instFamily", a.InstFamily},
+		{"instId", a.InstId},
+		{"ccy", a.Ccy},
+	} {
+		if v[1] == "" {
+			continue
+		}
+		sb.WriteString(":")
+		sb.WriteString(v[0])
+		sb.WriteString("=")
+		sb.WriteString(v[1])
+	}
+
+	return sb.String()
+}
+
+// ParseTopic is the reverse of Arg.Topic.
+func ParseTopic(topic string) (*Arg, error) {
+	parts := strings.Split(topic, ":")
+	if parts[0] == "" {
+		return nil, fmt.Errorf("invalid topic: %s", topic)
+	}
+
+	arg := &Arg{
+		Channel: parts[0],
+	}
+
+	for _, part := range parts[1:] {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid topic: %s", topic)
+		}
+
+		switch key {
+		case "instType":
+			arg.InstType = value
+		case "instFamily":
+			arg.InstFamily = value
+		case "instId":
+			arg.InstId = value
+		case "ccy":
+			arg.Ccy = value
+		default:
+			return nil, fmt.Errorf("invalid topic: %s", topic)
+		}
+	}
+
+	return arg, nil
+}
+
+type WsRequest struct {
+	ID   string `json:"id,omitempty"`
+	Op   string `json:"op"`
+	Args any    `json:"args"`
+}
+
+// WsMessage covers event responses, channel pushes and operation responses.
+type WsMessage struct {
+	// event responses, e.g. subscribe, login and error
+	Event  string `json:"event,omitempty"`
+	Code   string `json:"code,omitempty"`
+	Msg    string `json:"msg,omitempty"`
+	ConnId string `json:"connId,omitempty"`
+
+	// channel pushes
+	Arg    *Arg            `json:"arg,omitempty"`
+	Action string          `json:"action,omitempty"`
+	Data   json.RawMessage `json:"data,omitempty"`
+
+	// operation responses, e.g. order and cancel-order
+	ID      string `json:"id,omitempty"`
+	Op      string `json:"op,omitempty"`
+	InTime  string `json:"inTime,omitempty"`
+	OutTime string `json:"outTime,omitempty"`
+}
+
+type WsLoginArgs struct {
+	APIKey     string `json:"apiKey"`
+	Passphrase string `json:"passphrase"`
+	Timestamp  string `json:"timestamp"`
+	Sign       string `json:"sign"`
+}
+
+// GenWsLoginArgs signs a websocket login request with the same credentials used for REST requests.
+func GenWsLoginArgs(key, secret, passphrase string) (*WsLoginArgs, error) {
+	if key == "" || secret == "" || passphrase == "" {
+		return nil, fmt.Errorf("key, secret and passphrase needed when init client")
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	return &WsLoginArgs{
+		APIKey:     key,
+		Passphrase: passphrase,
+		Timestamp:  timestamp,
+		Sign:       Sign(secret, timestamp, http.MethodGet, "/users/self/verify", ""),
+	}, nil
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArgTopic(t *testing.T) {
+	tests := []struct {
+		name  string
+		arg   Arg
+		topic string
+	}{
+		{
+			name:  "channel only",
+			arg:   Arg{Channel: "account"},
+			topic: "account",
+		},
+		{
+			name:  "inst id",
+			arg:   Arg{Channel: "tickers", InstId: "BTC-USDT"},
+			topic: "tickers:instId=BTC-USDT",
+		},
+		{
+			name:  "fields in fixed order",
+			arg:   Arg{Channel: "orders", InstId: "BTC-USDT-SWAP", InstFamily: "BTC-USDT", InstType: "SWAP"},
+			topic: "orders:instType=SWAP:instFamily=BTC-USDT:instId=BTC-USDT-SWAP",
+		},
+		{
+			name:  "ccy",
+			arg:   Arg{Channel: "account", Ccy: "BTC"},
+			topic: "account:ccy=BTC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.topic, tt.arg.Topic())
+
+			arg, err := ParseTopic(tt.topic)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.arg, *arg)
+		})
+	}
+}
+
+func TestParseTopicErrors(t *testing.T) {
+	for _, topic := range []string{
+		"",
+		":instId=BTC-USDT",
+		"tickers:instId",
+		"tickers:instId=",
+		"tickers:unknown=BTC",
+	} {
+		_, err := ParseTopic(topic)
+		assert.NotNil(t, err, topic)
+	}
+}
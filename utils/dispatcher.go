@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultDispatcherQueueSize   = 1024
+	DefaultDispatcherIdleTimeout = time.Minute
+)
+
+type DispatcherCfg struct {
+	// QueueSize bounds the pending events of each topic, DefaultDispatcherQueueSize if zero
+	QueueSize int `validate:"gte=0"`
+	// Policy applies when a topic queue is full, Block stalls the websocket read goroutine
+	Policy SlowConsumerPolicy
+	// IdleTimeout retires the worker of a topic that has no listeners or received no event
+	// for this long, DefaultDispatcherIdleTimeout if zero
+	IdleTimeout time.Duration `validate:"gte=0"`
+}
+
+// QueueMetrics describes the state of one topic queue.
+// The counters start over when a retired topic is dispatched to again.
+type QueueMetrics struct {
+	Depth     int
+	Capacity  int
+	Delivered uint64
+	Dropped   uint64
+}
+
+type topicQueue struct {
+	events    chan any
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+	// Dispatch calls holding the queue, guarded by Dispatcher.qmu when incremented
+	senders atomic.Int32
+}
+
+// Dispatcher moves listener calls off the websocket read goroutine.
+// Every topic has its own bounded queue drained by a dedicated worker,
+// so events of a topic keep their order while a slow topic does not hold up the others.
+// A worker exits once its queue is drained and the topic has lost its listeners or stayed idle,
+// it is started again by the next event of the topic.
+type Dispatcher struct {
+	emitter *Emitter
+	size    int
+	policy  SlowConsumerPolicy
+	idle    time.Duration
+
+	// held for reading while enqueueing, so queues are never closed under a sender
+	mu        sync.RWMutex
+	closed    bool
+	done      chan struct{}
+	closeOnce sync.Once
+
+	qmu    sync.Mutex
+	queues map[string]*topicQueue
+	wg     sync.WaitGroup
+}
+
+func NewDispatcher(emitter *Emitter, cfg *DispatcherCfg) *Dispatcher {
+	d := &Dispatcher{
+		emitter: emitter,
+		size:    cfg.QueueSize,
+		policy:  cfg.Policy,
+		idle:    cfg.IdleTimeout,
+		done:    make(chan struct{}),
+		queues:  make(map[string]*topicQueue),
+	}
+
+	if d.size <= 0 {
+		d.size = DefaultDispatcherQueueSize
+	}
+
+	if d.idle <= 0 {
+		d.idle = DefaultDispatcherIdleTimeout
+	}
+
+	return d
+}
+
+// Dispatch enqueues argument for the listeners of event.
+func (d *Dispatcher) Dispatch(event string, argument any) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		return
+	}
+
+	q := d.acquire(event)
+	if q == nil {
+		return
+	}
+	defer q.senders.Add(-1)
+
+	switch d.policy {
+	case DropNewest:
+		select {
+		case q.events <- argument:
+		default:
+			q.dropped.Add(1)
+		}
+	case DropOldest:
+		for {
+			select {
+			case q.events <- argument:
+				return
+			default:
+			}
+
+			select {
+			case <-q.events:
+				q.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		select {
+		case q.events <- argument:
+		case <-d.done:
+		}
+	}
+}
+
+// acquire returns the queue of event with a sender registered, so the worker is not retired under it.
+// Events of a topic without listeners and without a queue are dropped.
+func (d *Dispatcher) acquire(event string) *topicQueue {
+	d.qmu.Lock()
+	defer d.qmu.Unlock()
+
+	q, ok := d.queues[event]
+	if ok {
+		q.senders.Add(1)
+		return q
+	}
+
+	if d.emitter.ListenerCount(event) == 0 {
+		return nil
+	}
+
+	q = &topicQueue{
+		events: make(chan any, d.size),
+	}
+	q.senders.Add(1)
+	d.queues[event] = q
+
+	d.wg.Add(1)
+	go d.work(event, q)
+
+	return q
+}
+
+func (d *Dispatcher) work(event string, q *topicQueue) {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(d.idle)
+	defer ticker.Stop()
+
+	active := false
+	for {
+		select {
+		case argument, ok := <-q.events:
+			if !ok {
+				return
+			}
+
+			d.emitter.Emit(event, argument)
+			q.delivered.Add(1)
+			active = true
+
+			if d.emitter.ListenerCount(event) == 0 && d.retire(event, q) {
+				return
+			}
+		case <-ticker.C:
+			if (!active || d.emitter.ListenerCount(event) == 0) && d.retire(event, q) {
+				return
+			}
+			active = false
+		}
+	}
+}
+
+// retire removes q when nothing is queued or being enqueued, the caller's worker must exit if it returns true.
+func (d *Dispatcher) retire(event string, q *topicQueue) bool {
+	d.qmu.Lock()
+	defer d.qmu.Unlock()
+
+	if q.senders.Load() > 0 || len(q.events) > 0 {
+		return false
+	}
+
+	if d.queues[event] == q {
+		delete(d.queues, event)
+	}
+
+	return true
+}
+
+// Metrics returns a snapshot of every topic queue.
+func (d *Dispatcher) Metrics() map[string]QueueMetrics {
+	d.qmu.Lock()
+	defer d.qmu.Unlock()
+
+	metrics := make(map[string]QueueMetrics, len(d.queues))
+	for event, q := range d.queues {
+		metrics[event] = QueueMetrics{
+			Depth:     len(q.events),
+			Capacity:  cap(q.events),
+			Delivered: q.delivered.Load(),
+			Dropped:   q.dropped.Load(),
+		}
+	}
+
+	return metrics
+}
+
+// Close stops accepting events, the workers exit after delivering what is already queued.
+func (d *Dispatcher) Close() {
+	d.closeOnce.Do(func() {
+		// unblock senders waiting on a full queue before taking the write lock
+		close(d.done)
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		d.closed = true
+
+		d.qmu.Lock()
+		defer d.qmu.Unlock()
+
+		for _, q := range d.queues {
+			close(q.events)
+		}
+	})
+}
+
+// Wait blocks until every worker has exited after Close.
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
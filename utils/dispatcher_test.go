@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatcherPerTopicOrder(t *testing.T) {
+	const n = 1000
+
+	e := NewEmitter()
+	d := NewDispatcher(e, &DispatcherCfg{QueueSize: 16})
+
+	var (
+		mu  sync.Mutex
+		got = make(map[string][]int)
+	)
+	for _, topic := range []string{"a", "b", "c"} {
+		topic := topic
+		e.On(topic, func(v any) {
+			mu.Lock()
+			got[topic] = append(got[topic], v.(int))
+			mu.Unlock()
+		})
+	}
+
+	for i := 0; i < n; i++ {
+		d.Dispatch("a", i)
+		d.Dispatch("b", i)
+		d.Dispatch("c", i)
+	}
+	d.Close()
+	d.Wait()
+
+	want := make([]int, n)
+	for i := range want {
+		want[i] = i
+	}
+	for _, topic := range []string{"a", "b", "c"} {
+		assert.Equal(t, want, got[topic], topic)
+	}
+}
+
+func TestDispatcherMetrics(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    SlowConsumerPolicy
+		delivered []int
+		dropped   uint64
+	}{
+		{
+			name:      "drop newest",
+			policy:    DropNewest,
+			delivered: []int{0, 1, 2},
+			dropped:   3,
+		},
+		{
+			name:      "drop oldest",
+			policy:    DropOldest,
+			delivered: []int{0, 4, 5},
+			dropped:   3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEmitter()
+			d := NewDispatcher(e, &DispatcherCfg{QueueSize: 2, Policy: tt.policy})
+
+			var (
+				got     []int
+				started = make(chan struct{})
+				release = make(chan struct{})
+			)
+			e.On("topic", func(v any) {
+				if v.(int) == 0 {
+					close(started)
+					<-release
+				}
+				got = append(got, v.(int))
+			})
+			e.On("idle", func(any) {})
+
+			// the worker holds event 0, so the queue fills up behind it
+			d.Dispatch("topic", 0)
+			<-started
+			for i := 1; i < 6; i++ {
+				d.Dispatch("topic", i)
+			}
+
+			m := d.Metrics()
+			assert.Equal(t, QueueMetrics{Depth: 2, Capacity: 2, Delivered: 0, Dropped: tt.dropped}, m["topic"])
+			_, ok := m["idle"]
+			assert.False(t, ok)
+
+			close(release)
+			d.Close()
+			d.Wait()
+
+			assert.Equal(t, tt.delivered, got)
+			assert.Equal(t, uint64(len(tt.delivered)), d.Metrics()["topic"].Delivered)
+		})
+	}
+}
+
+func TestDispatcherRetiresWorkers(t *testing.T) {
+	const topics = 100
+
+	e := NewEmitter()
+	d := NewDispatcher(e, &DispatcherCfg{IdleTimeout: 10 * time.Millisecond})
+	defer d.Close()
+
+	base := runtime.NumGoroutine()
+
+	var (
+		wg   sync.WaitGroup
+		offs []func()
+	)
+	for i := 0; i < topics; i++ {
+		offs = append(offs, e.On(fmt.Sprintf("topic-%d", i), func(any) { wg.Done() }))
+	}
+
+	wg.Add(topics)
+	for i := 0; i < topics; i++ {
+		d.Dispatch(fmt.Sprintf("topic-%d", i), i)
+	}
+	wg.Wait()
+
+	assert.Len(t, d.Metrics(), topics)
+	assert.GreaterOrEqual(t, runtime.NumGoroutine(), base+topics)
+
+	// unsubscribed topics are retired while the others are kept busy
+	for _, off := range offs[:topics/2] {
+		off()
+	}
+	waitFor(t, func() bool {
+		wg.Add(topics / 2)
+		for i := topics / 2; i < topics; i++ {
+			d.Dispatch(fmt.Sprintf("topic-%d", i), i)
+		}
+		wg.Wait()
+
+		return len(d.Metrics()) == topics/2
+	})
+
+	// and the rest once they stay idle
+	waitFor(t, func() bool {
+		return len(d.Metrics()) == 0 && runtime.NumGoroutine() <= base
+	})
+
+	// events without listeners do not start a worker
+	d.Dispatch("topic-0", 0)
+	assert.Empty(t, d.Metrics())
+
+	// a retired topic with listeners starts over
+	wg.Add(1)
+	d.Dispatch(fmt.Sprintf("topic-%d", topics-1), 0)
+	wg.Wait()
+	assert.Equal(t, uint64(1), d.Metrics()[fmt.Sprintf("topic-%d", topics-1)].Delivered)
+}
+
+// waitFor polls cond on the calling goroutine, so it does not add to runtime.NumGoroutine
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within 1s")
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+}
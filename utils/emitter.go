@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Listener is the untyped callback used by websocket clients.
+type Listener func(any)
+
+type listenerEntry struct {
+	id uint64
+	fn Listener
+}
+
+// Emitter dispatches websocket events to the listeners registered for a topic.
+type Emitter struct {
+	mu        sync.RWMutex
+	nextID    uint64
+	listeners map[string][]*listenerEntry
+}
+
+func NewEmitter() *Emitter {
+	return &Emitter{
+		listeners: make(map[string][]*listenerEntry),
+	}
+}
+
+// On registers listener for event, the returned func removes exactly this registration.
+func (e *Emitter) On(event string, listener Listener) func() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.nextID++
+	id := e.nextID
+
+	// copy on write, so Emit can iterate over a snapshot without holding the lock
+	ls := make([]*listenerEntry, 0, len(e.listeners[event])+1)
+	ls = append(ls, e.listeners[event]...)
+	e.listeners[event] = append(ls, &listenerEntry{id: id, fn: listener})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			e.remove(event, func(l *listenerEntry) bool { return l.id == id })
+		})
+	}
+}
+
+// Off removes every registration of listener for event.
+// Functions are compared by code pointer, so closures created by the same
+// literal cannot be told apart; use the func returned by On to remove one of them.
+func (e *Emitter) Off(event string, listener Listener) {
+	ptr := reflect.ValueOf(listener).Pointer()
+
+	e.remove(event, func(l *listenerEntry) bool {
+		return reflect.ValueOf(l.fn).Pointer() == ptr
+	})
+}
+
+func (e *Emitter) remove(event string, match func(*listenerEntry) bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ls := make([]*listenerEntry, 0, len(e.listeners[event]))
+	for _, l := range e.listeners[event] {
+		if !match(l) {
+			ls = append(ls, l)
+		}
+	}
+
+	if len(ls) == 0 {
+		delete(e.listeners, event)
+		return
+	}
+
+	e.listeners[event] = ls
+}
+
+// Emit calls the listeners of event in registration order.
+func (e *Emitter) Emit(event string, argument any) {
+	e.mu.RLock()
+	ls := e.listeners[event]
+	e.mu.RUnlock()
+
+	for _, l := range ls {
+		l.fn(argument)
+	}
+}
+
+// ListenerCount returns the number of listeners registered for event.
+func (e *Emitter) ListenerCount(event string) int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return len(e.listeners[event])
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSource struct {
+	*Emitter
+}
+
+func (s testSource) AddListener(event string, listener Listener) func() {
+	return s.On(event, listener)
+}
+
+func TestEmitterUnsubscribe(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove func(e *Emitter, offA, offB func(), a Listener)
+		want   []string
+	}{
+		{
+			name:   "keep all",
+			remove: func(e *Emitter, offA, offB func(), a Listener) {},
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "handle removes one registration",
+			remove: func(e *Emitter, offA, offB func(), a Listener) { offA() },
+			want:   []string{"b"},
+		},
+		{
+			name: "handle is idempotent",
+			remove: func(e *Emitter, offA, offB func(), a Listener) {
+				offB()
+				offB()
+			},
+			want: []string{"a"},
+		},
+		{
+			name:   "off removes by func",
+			remove: func(e *Emitter, offA, offB func(), a Listener) { e.Off("topic", a) },
+			want:   []string{"b"},
+		},
+		{
+			name: "remove all",
+			remove: func(e *Emitter, offA, offB func(), a Listener) {
+				offA()
+				offB()
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+
+			e := NewEmitter()
+			a := func(any) { got = append(got, "a") }
+			offA := e.On("topic", a)
+			offB := e.On("topic", func(any) { got = append(got, "b") })
+
+			tt.remove(e, offA, offB, a)
+			e.Emit("topic", nil)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, len(tt.want), e.ListenerCount("topic"))
+		})
+	}
+}
+
+func TestEmitterRemoveDuringEmit(t *testing.T) {
+	var (
+		got  []string
+		offB func()
+	)
+
+	e := NewEmitter()
+	e.On("topic", func(any) {
+		got = append(got, "a")
+		offB()
+	})
+	offB = e.On("topic", func(any) { got = append(got, "b") })
+
+	// the running Emit works on a snapshot, so b is removed from the next emit only
+	e.Emit("topic", nil)
+	e.Emit("topic", nil)
+
+	assert.Equal(t, []string{"a", "b", "a"}, got)
+	assert.Equal(t, 1, e.ListenerCount("topic"))
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"sync"
+)
+
+// EventSource is implemented by every websocket client.
+type EventSource interface {
+	AddListener(event string, listener Listener) func()
+}
+
+// On registers a typed listener for event, payloads of any other type are ignored.
+// The returned func unsubscribes the listener.
+func On[T any](src EventSource, event string, fn func(T)) func() {
+	return src.AddListener(event, func(e any) {
+		data, ok := e.(T)
+		if !ok {
+			return
+		}
+		fn(data)
+	})
+}
+
+// SlowConsumerPolicy decides what happens when the channel returned by Chan is full.
+type SlowConsumerPolicy int
+
+const (
+	// Block waits until the consumer reads, which stalls the websocket client.
+	Block SlowConsumerPolicy = iota
+	// DropNewest discards the incoming event.
+	DropNewest
+	// DropOldest discards the oldest buffered event to make room for the incoming one.
+	DropOldest
+)
+
+type ChanCfg struct {
+	// Buffer is the capacity of the returned channel
+	Buffer int
+	Policy SlowConsumerPolicy
+	// OnDrop is called with every discarded event, optional
+	OnDrop func(event string, data any)
+}
+
+// Chan delivers the typed payloads of event on a channel.
+// The returned func unsubscribes and closes the channel.
+func Chan[T any](src EventSource, event string, cfg ChanCfg) (<-chan T, func()) {
+	var (
+		ch   = make(chan T, cfg.Buffer)
+		done = make(chan struct{})
+		// held for reading while sending, so the channel is never closed under a sender
+		mu sync.RWMutex
+	)
+
+	if cfg.Policy == DropOldest && cfg.Buffer <= 0 {
+		// nothing buffered to drop
+		cfg.Policy = DropNewest
+	}
+
+	dropped := func(data T) {
+		if cfg.OnDrop != nil {
+			cfg.OnDrop(event, data)
+		}
+	}
+
+	off := On(src, event, func(data T) {
+		mu.RLock()
+		defer mu.RUnlock()
+
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		switch cfg.Policy {
+		case DropNewest:
+			select {
+			case ch <- data:
+			default:
+				dropped(data)
+			}
+		case DropOldest:
+			for {
+				select {
+				case ch <- data:
+					return
+				default:
+				}
+
+				select {
+				case old := <-ch:
+					dropped(old)
+				default:
+				}
+			}
+		default:
+			select {
+			case ch <- data:
+			case <-done:
+			}
+		}
+	})
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			off()
+			close(done)
+
+			mu.Lock()
+			close(ch)
+			mu.Unlock()
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2023, LinstoHu
+ * All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnIgnoresOtherTypes(t *testing.T) {
+	var got []int
+
+	src := testSource{NewEmitter()}
+	off := On(src, "topic", func(v int) { got = append(got, v) })
+
+	src.Emit("topic", 1)
+	src.Emit("topic", "not an int")
+	off()
+	src.Emit("topic", 2)
+
+	assert.Equal(t, []int{1}, got)
+}
+
+func TestChanPolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     ChanCfg
+		want    []int
+		dropped []int
+	}{
+		{
+			name:    "drop newest",
+			cfg:     ChanCfg{Buffer: 2, Policy: DropNewest},
+			want:    []int{1, 2},
+			dropped: []int{3, 4},
+		},
+		{
+			name:    "drop oldest",
+			cfg:     ChanCfg{Buffer: 2, Policy: DropOldest},
+			want:    []int{3, 4},
+			dropped: []int{1, 2},
+		},
+		{
+			name:    "drop oldest without buffer falls back to drop newest",
+			cfg:     ChanCfg{Buffer: 0, Policy: DropOldest},
+			want:    nil,
+			dropped: []int{1, 2, 3, 4},
+		},
+		{
+			name: "block",
+			cfg:  ChanCfg{Buffer: 4, Policy: Block},
+			want: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dropped []int
+
+			tt.cfg.OnDrop = func(event string, data any) {
+				assert.Equal(t, "topic", event)
+				dropped = append(dropped, data.(int))
+			}
+
+			src := testSource{NewEmitter()}
+			ch, off := Chan[int](src, "topic", tt.cfg)
+
+			for i := 1; i <= 4; i++ {
+				src.Emit("topic", i)
+			}
+			off()
+
+			var got []int
+			for v := range ch {
+				got = append(got, v)
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.dropped, dropped)
+			assert.Equal(t, 0, src.ListenerCount("topic"))
+		})
+	}
+}
+
+func TestChanBlockUnsubscribeReleasesSender(t *testing.T) {
+	src := testSource{NewEmitter()}
+	ch, off := Chan[int](src, "topic", ChanCfg{Policy: Block})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// nobody reads, the send blocks until unsubscribe
+		src.Emit("topic", 1)
+	}()
+
+	off()
+	wg.Wait()
+
+	_, ok := <-ch
+	assert.False(t, ok)
+}
@@ -27,9 +27,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/chuckpreslar/emission"
 	"github.com/go-playground/validator"
 	"github.com/gorilla/websocket"
+	"github.com/linstohu/nexapi/utils"
 	"github.com/linstohu/nexapi/woox/websocket/types"
 	cmap "github.com/orcaman/concurrent-map/v2"
 )
@@ -56,7 +56,8 @@ type WooXWebsocketClient struct {
 	sending       sync.Mutex
 	subscriptions cmap.ConcurrentMap[string, struct{}]
 
-	emitter *emission.Emitter
+	emitter    *utils.Emitter
+	dispatcher *utils.Dispatcher
 }
 
 type WooXWebsocketCfg struct {
@@ -70,6 +71,9 @@ type WooXWebsocketCfg struct {
 
 	// Logger
 	Logger *slog.Logger
+
+	// Dispatcher calls listeners off the read goroutine when set
+	Dispatcher *utils.DispatcherCfg
 }
 
 func NewWooXWebsocketClient(cfg *WooXWebsocketCfg) (*WooXWebsocketClient, error) {
@@ -90,13 +94,17 @@ func NewWooXWebsocketClient(cfg *WooXWebsocketCfg) (*WooXWebsocketClient, error)
 		autoReconnect: cfg.AutoReconnect,
 
 		subscriptions: cmap.New[struct{}](),
-		emitter:       emission.NewEmitter(),
+		emitter:       utils.NewEmitter(),
 	}
 
 	if cli.logger == nil {
 		cli.logger = slog.Default()
 	}
 
+	if cfg.Dispatcher != nil {
+		cli.dispatcher = utils.NewDispatcher(cli.emitter, cfg.Dispatcher)
+	}
+
 	return cli, nil
 }
 
@@ -122,6 +130,10 @@ func (w *WooXWebsocketClient) Close() error {
 
 	w.cancel()
 
+	if w.dispatcher != nil {
+		w.dispatcher.Close()
+	}
+
 	return nil
 }
 
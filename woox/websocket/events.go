@@ -17,18 +17,85 @@
 
 package websocket
 
-import "github.com/chuckpreslar/emission"
+import (
+	"github.com/linstohu/nexapi/utils"
+	"github.com/linstohu/nexapi/woox/websocket/types"
+)
 
-type Listener func(any)
+type Listener = utils.Listener
 
-func (w *WooXWebsocketClient) AddListener(event string, listener Listener) *emission.Emitter {
+// AddListener registers listener for event, the returned func removes it.
+func (w *WooXWebsocketClient) AddListener(event string, listener Listener) func() {
 	return w.emitter.On(event, listener)
 }
 
-func (w *WooXWebsocketClient) RemoveListener(event string, listener Listener) *emission.Emitter {
-	return w.emitter.Off(w, listener)
+// RemoveListener removes listener from event, prefer the func returned by AddListener for closures.
+func (w *WooXWebsocketClient) RemoveListener(event string, listener Listener) {
+	w.emitter.Off(event, listener)
 }
 
-func (w *WooXWebsocketClient) GetListeners(event string, argument any) *emission.Emitter {
-	return w.emitter.Emit(event, argument)
+func (w *WooXWebsocketClient) GetListeners(event string, argument any) {
+	if w.dispatcher != nil {
+		w.dispatcher.Dispatch(event, argument)
+		return
+	}
+
+	w.emitter.Emit(event, argument)
+}
+
+// DispatcherMetrics reports queue depth and dropped events per topic, it is nil without a dispatcher.
+func (w *WooXWebsocketClient) DispatcherMetrics() map[string]utils.QueueMetrics {
+	if w.dispatcher == nil {
+		return nil
+	}
+
+	return w.dispatcher.Metrics()
+}
+
+func (w *WooXWebsocketClient) OnOrderbook(topic string, fn func(*types.Orderbook)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnTrade(topic string, fn func(*types.Trade)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnTicker(topic string, fn func(*types.Ticker24H)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnAllTickers(topic string, fn func(*types.Tickers)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnBbo(topic string, fn func(*types.BBO)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnAllBbos(topic string, fn func(*types.AllBBO)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnKline(topic string, fn func(*types.Kline)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnIndexPrice(topic string, fn func(*types.IndexPrice)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnMarkPrice(topic string, fn func(*types.MarkPrice)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnMarkPrices(topic string, fn func(*types.MarkPrices)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnOpenInterest(topic string, fn func(*types.OpenInterest)) func() {
+	return utils.On(w, topic, fn)
+}
+
+func (w *WooXWebsocketClient) OnEstFundingRate(topic string, fn func(*types.EstFundingRate)) func() {
+	return utils.On(w, topic, fn)
 }